@@ -0,0 +1,264 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// sqlDialect is a flavour of SQL the migrations are written in
+type sqlDialect struct {
+	Name        string
+	Quote       string // around identifiers which need it
+	DefaultType string // of columns without a type attribute
+}
+
+var sqlDialects = map[string]*sqlDialect{
+	"postgres": {Name: "postgres", Quote: `"`, DefaultType: "text"},
+	"mysql":    {Name: "mysql", Quote: "`", DefaultType: "text"},
+	"sqlite":   {Name: "sqlite", Quote: `"`, DefaultType: "TEXT"},
+}
+
+var plainIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ident quotes a table or column name when it is not a plain identifier
+func (d *sqlDialect) ident(name string) string {
+	if plainIdentifier.MatchString(name) {
+		return name
+	}
+	return d.Quote + strings.Replace(name, d.Quote, d.Quote+d.Quote, -1) + d.Quote
+}
+
+func (d *sqlDialect) idents(names []string) string {
+	var quoted []string
+	for _, n := range names {
+		quoted = append(quoted, d.ident(n))
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// literal quotes a string as an SQL literal
+func literal(s string) string {
+	return "'" + strings.Replace(s, "'", "''", -1) + "'"
+}
+
+// enumValues returns the values of an enum as a list of SQL literals
+func enumValues(en *Enum) string {
+	var values []string
+	for _, v := range en.Values {
+		values = append(values, literal(v))
+	}
+	return strings.Join(values, ", ")
+}
+
+// columnType returns the type attribute of a column, or the default type.
+// Postgres refers to the enums by name, mysql spells them out in the
+// column and sqlite stores them as text.
+func (d *sqlDialect) columnType(c Column, e *Erd) (string, bool) {
+	t := c.Type
+	if t == "" {
+		return d.DefaultType, false
+	}
+	if en := e.enum(t); en != nil {
+		switch d.Name {
+		case "mysql":
+			return "ENUM(" + enumValues(en) + ")", true
+		case "sqlite":
+			return d.DefaultType, true
+		}
+		return d.ident(en.Title), true
+	}
+	return t, true
+}
+
+// primaryKey returns the names of the primary key columns of a table
+func primaryKey(t *Table) []string {
+	var names []string
+	for _, c := range t.Columns {
+		if strings.Contains(columnKey(c.Title), "primary") {
+			names = append(names, columnName(c.Title))
+		}
+	}
+	return names
+}
+
+// keyColumns returns the primary key of a table, with the columns of its
+// identifying foreign keys folded in
+func keyColumns(t *Table, fks []foreignKey) []string {
+	key := primaryKey(t)
+	for _, fk := range fks {
+		if !fk.Identifying || fk.Table != t.Title {
+			continue
+		}
+		for _, c := range fk.Columns {
+			found := false
+			for _, k := range key {
+				found = found || k == c
+			}
+			if !found {
+				key = append(key, c)
+			}
+		}
+	}
+	return key
+}
+
+// foreignKey is a foreign key constraint derived from a relation
+type foreignKey struct {
+	Name        string
+	Table       string // titles of the tables
+	Columns     []string
+	RefTable    string
+	RefColumns  []string
+	Identifying bool // the columns are part of the primary key of Table
+}
+
+func isMany(cardinality string) bool {
+	return cardinality == "*" || cardinality == "+"
+}
+
+// referencing finds the columns of child referring to the primary key of
+// parent: a column named <parent>_<key>, or a foreign key (+) column named
+// like the key. Illegal characters of the parent name may be written as _.
+func referencing(child, parent *Table) ([]string, bool) {
+	key := primaryKey(parent)
+	if len(key) == 0 {
+		return nil, false
+	}
+	var columns []string
+	for _, k := range key {
+		found := ""
+		for _, c := range child.Columns {
+			name := columnName(c.Title)
+			if name == parent.Title+"_"+k || name == parent.Name+"_"+k ||
+				(name == k && strings.Contains(columnKey(c.Title), "foreign")) {
+				found = name
+				break
+			}
+		}
+		if found == "" {
+			return nil, false
+		}
+		columns = append(columns, found)
+	}
+	return columns, true
+}
+
+// foreignKeys derives the foreign keys from the relations and supertypes.
+// The table on the many side of a relation refers to the other one; with one
+// on both sides, either table may hold the reference. Many-to-many relations
+// have none.
+func foreignKeys(e *Erd) []foreignKey {
+	var keys []foreignKey
+	seen := map[string]bool{}
+	for _, r := range e.Relations {
+		left, right := e.Tables[r.LeftTableName], e.Tables[r.RightTableName]
+		if left == nil || right == nil {
+			continue
+		}
+		var candidates [][2]*Table // child, parent
+		switch {
+		case isMany(r.LeftCardinality) && isMany(r.RightCardinality):
+		case isMany(r.LeftCardinality):
+			candidates = [][2]*Table{{left, right}}
+		case isMany(r.RightCardinality):
+			candidates = [][2]*Table{{right, left}}
+		default:
+			candidates = [][2]*Table{{right, left}, {left, right}}
+		}
+		for _, c := range candidates {
+			columns, ok := referencing(c[0], c[1])
+			if !ok {
+				continue
+			}
+			fk := foreignKey{
+				Name:        replaceAllIllegal("fk_" + c[0].Title + "_" + c[1].Title),
+				Table:       c[0].Title,
+				Columns:     columns,
+				RefTable:    c[1].Title,
+				RefColumns:  primaryKey(c[1]),
+				Identifying: r.Identifying,
+			}
+			if !seen[fk.Name] {
+				seen[fk.Name] = true
+				keys = append(keys, fk)
+			}
+			break
+		}
+	}
+
+	// table per type: a subtype refers to its supertype with its primary key
+	for _, name := range e.TableNames {
+		child := e.Tables[name]
+		if child == nil || e.Tables[child.Extends] == nil {
+			continue
+		}
+		parent := e.Tables[child.Extends]
+		columns, ok := referencing(child, parent)
+		if key := primaryKey(child); !ok && len(key) > 0 && len(key) == len(primaryKey(parent)) {
+			columns, ok = key, true
+		}
+		if !ok {
+			continue
+		}
+		fk := foreignKey{
+			Name:        replaceAllIllegal("fk_" + child.Title + "_" + parent.Title),
+			Table:       child.Title,
+			Columns:     columns,
+			RefTable:    parent.Title,
+			RefColumns:  primaryKey(parent),
+			Identifying: true,
+		}
+		if !seen[fk.Name] {
+			seen[fk.Name] = true
+			keys = append(keys, fk)
+		}
+	}
+	return keys
+}
+
+// constraint returns the foreign key clause of a CREATE or ALTER TABLE
+func (d *sqlDialect) constraint(fk foreignKey) string {
+	return fmt.Sprintf("CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s)",
+		d.ident(fk.Name), d.idents(fk.Columns), d.ident(fk.RefTable), d.idents(fk.RefColumns))
+}
+
+// columnDefinition returns the type of a column with its constraints, the
+// unique and check constraints only when asked for, and whether the type
+// was given
+func (d *sqlDialect) columnDefinition(c Column, e *Erd, all bool) (string, bool) {
+	def, ok := d.columnType(c, e)
+	if c.NotNull {
+		def += " NOT NULL"
+	}
+	if c.Default != "" {
+		def += " DEFAULT " + c.Default
+	}
+	if all && c.Unique {
+		def += " UNIQUE"
+	}
+	if all && c.Check != "" {
+		def += " CHECK (" + c.Check + ")"
+	}
+	return def, ok
+}
+
+// createTable returns the CREATE TABLE statement of a table of the ERD, with
+// the given primary key and foreign keys inline, and the columns lacking a type
+func (d *sqlDialect) createTable(e *Erd, t *Table, key []string, fks []foreignKey) (string, []string) {
+	var lines, untyped []string
+	for _, c := range t.Columns {
+		def, ok := d.columnDefinition(c, e, true)
+		if !ok {
+			untyped = append(untyped, columnName(c.Title))
+		}
+		lines = append(lines, d.ident(columnName(c.Title))+" "+def)
+	}
+	if len(key) > 0 {
+		lines = append(lines, "PRIMARY KEY ("+d.idents(key)+")")
+	}
+	for _, fk := range fks {
+		lines = append(lines, d.constraint(fk))
+	}
+	return fmt.Sprintf("CREATE TABLE %s (\n  %s\n)", d.ident(t.Title), strings.Join(lines, ",\n  ")), untyped
+}
@@ -0,0 +1,116 @@
+package main
+
+// copyTable copies a table, so its columns and attributes can be annotated
+func copyTable(t *Table) *Table {
+	c := *t
+	c.Columns = append([]Column(nil), t.Columns...)
+	c.TableAttributes = map[string]string{}
+	for k, v := range t.TableAttributes {
+		c.TableAttributes[k] = v
+	}
+	return &c
+}
+
+// markColumns sets the change of every column of the table
+func markColumns(t *Table, change string) {
+	for i := range t.Columns {
+		t.Columns[i].Change = change
+	}
+}
+
+// DiffModel merges two schemas into one, annotated with the changes of
+// the diff: it holds the new schema, with the removed tables, columns and
+// relations of the old schema put back in
+func DiffModel(old, new *Erd, d *SchemaDiff) *Erd {
+	e := &Erd{
+		Title:           new.Title,
+		GraphAttributes: new.GraphAttributes,
+		Colors:          new.Colors,
+		Groups:          new.Groups,
+		Tables:          map[string]*Table{},
+		TableNames:      append([]string(nil), new.TableNames...),
+		Relations:       append([]Relation(nil), new.Relations...),
+		Relationships:   new.Relationships,
+		Enums:           new.Enums,
+		Notes:           new.Notes,
+	}
+	for name, t := range new.Tables {
+		e.Tables[name] = copyTable(t)
+	}
+
+	// the old names of renamed tables, to draw removed relations to them
+	renamed := map[string]string{}
+	for _, tc := range d.Tables {
+		name := replaceAllIllegal(tc.Name)
+		switch tc.Kind {
+		case ChangeAdded:
+			t := e.Tables[name]
+			t.Change = ChangeAdded
+			markColumns(t, ChangeAdded)
+		case ChangeRemoved:
+			t := copyTable(old.Tables[name])
+			t.Change = ChangeRemoved
+			markColumns(t, ChangeRemoved)
+			e.Tables[name] = t
+			e.TableNames = append(e.TableNames, name)
+		case ChangeRenamed, ChangeChanged:
+			t := e.Tables[name]
+			t.Change = ChangeChanged
+			oldName := name
+			if tc.Kind == ChangeRenamed {
+				oldName = replaceAllIllegal(tc.OldName)
+				renamed[oldName] = name
+				if t.TableAttributes["label"] == "" {
+					t.TableAttributes["label"] = "renamed from " + tc.OldName
+				}
+			}
+			for _, cc := range tc.Columns {
+				if cc.Kind == ChangeRemoved {
+					for _, c := range old.Tables[oldName].Columns {
+						if columnName(c.Title) == cc.Name {
+							c.Change = ChangeRemoved
+							t.Columns = append(t.Columns, c)
+						}
+					}
+					continue
+				}
+				for i, c := range t.Columns {
+					if columnName(c.Title) == cc.Name {
+						t.Columns[i].Change = cc.Kind
+					}
+				}
+			}
+		}
+	}
+
+	for _, rc := range d.Relations {
+		switch rc.Kind {
+		case ChangeRemoved:
+			r := old.Relations[rc.oldIndex]
+			if n, ok := renamed[r.LeftTableName]; ok {
+				r.LeftTableName = n
+			}
+			if n, ok := renamed[r.RightTableName]; ok {
+				r.RightTableName = n
+			}
+			r.Change = ChangeRemoved
+			e.Relations = append(e.Relations, r)
+		default:
+			e.Relations[rc.newIndex].Change = rc.Kind
+		}
+	}
+
+	for _, t := range e.Tables {
+		t.Connected = false
+	}
+	for _, r := range e.Relations {
+		e.Connect(r.LeftTableName)
+		e.Connect(r.RightTableName)
+	}
+	for _, r := range e.Relationships {
+		for _, p := range r.Participants {
+			e.Connect(p.TableName)
+		}
+	}
+	return e
+}
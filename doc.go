@@ -0,0 +1,221 @@
+package main
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+)
+
+// docColumn is a column in the documentation export
+type docColumn struct {
+	Name  string
+	Key   string // PK, FK or both
+	Type  string
+	Label string
+	Notes []string
+}
+
+// docTable is a table in the documentation export, with the notes
+// attached to it
+type docTable struct {
+	Title   string
+	Extends string
+	Label   string
+	Notes   []string
+	Columns []docColumn
+}
+
+// docEnum is an enum in the documentation export
+type docEnum struct {
+	Name   string
+	Values []string
+}
+
+// document is the ERD as written by the Markdown and HTML exports
+type document struct {
+	Title     string
+	Notes     []string // not attached to a table
+	Tables    []docTable
+	Relations []string
+	Enums     []docEnum
+}
+
+// documentation collects the tables, relations, enums and notes of the ERD.
+// The note statements go with the table or column they are attached to,
+// after the note attributes.
+func documentation(e *Erd) document {
+	title := func(name string) string {
+		if t, ok := e.Tables[name]; ok {
+			return t.Title
+		}
+		return name
+	}
+
+	d := document{Title: e.Title.TitleAttributes["label"]}
+	tableNotes := map[string][]string{}
+	columnNotes := map[string]map[string][]string{}
+	for _, n := range e.Notes {
+		t, c := e.attachment(n)
+		switch {
+		case t == nil:
+			d.Notes = append(d.Notes, n.Text)
+		case c == nil:
+			tableNotes[t.Name] = append(tableNotes[t.Name], n.Text)
+		default:
+			if columnNotes[t.Name] == nil {
+				columnNotes[t.Name] = map[string][]string{}
+			}
+			columnNotes[t.Name][c.Title] = append(columnNotes[t.Name][c.Title], n.Text)
+		}
+	}
+
+	for _, name := range e.TableNames {
+		t, ok := e.Tables[name]
+		if !ok {
+			continue
+		}
+		table := docTable{Title: t.Title, Label: t.TableAttributes["label"]}
+		if t.Extends != "" {
+			table.Extends = title(t.Extends)
+		}
+		if text := t.TableAttributes["note"]; text != "" {
+			table.Notes = append(table.Notes, text)
+		}
+		table.Notes = append(table.Notes, tableNotes[name]...)
+		for _, c := range t.Columns {
+			column := docColumn{
+				Name:  columnName(c.Title),
+				Type:  c.TypeLabel(),
+				Label: c.ColumnAttributes["label"],
+			}
+			var keys []string
+			if strings.Contains(columnKey(c.Title), "primary") {
+				keys = append(keys, "PK")
+			}
+			if strings.Contains(columnKey(c.Title), "foreign") {
+				keys = append(keys, "FK")
+			}
+			column.Key = strings.Join(keys, ", ")
+			if text := c.ColumnAttributes["note"]; text != "" {
+				column.Notes = append(column.Notes, text)
+			}
+			column.Notes = append(column.Notes, columnNotes[name][c.Title]...)
+			table.Columns = append(table.Columns, column)
+		}
+		d.Tables = append(d.Tables, table)
+	}
+
+	for _, r := range e.Relations {
+		operator := "--"
+		if r.Identifying {
+			operator = "=="
+		}
+		d.Relations = append(d.Relations, fmt.Sprintf("%s %s%s%s %s",
+			title(r.LeftTableName), r.LeftCardinality, operator, r.RightCardinality, title(r.RightTableName)))
+	}
+	for _, en := range e.Enums {
+		d.Enums = append(d.Enums, docEnum{Name: en.Title, Values: en.Values})
+	}
+	return d
+}
+
+// markdownEscaper keeps the markup in the texts of the ERD from being read
+// as HTML
+var markdownEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// markdownCell escapes a text for a cell of a Markdown table, breaking its
+// lines with <br>
+func markdownCell(s string) string {
+	s = strings.Replace(markdownEscaper.Replace(s), "|", `\|`, -1)
+	return strings.Replace(strings.TrimRight(s, "\n"), "\n", "<br>", -1)
+}
+
+// markdownParagraph escapes a text, breaking its lines with trailing double
+// spaces
+func markdownParagraph(s string) string {
+	return strings.Replace(strings.TrimRight(markdownEscaper.Replace(s), "\n"), "\n", "  \n", -1)
+}
+
+// markdownDoc writes the documentation of the ERD in Markdown
+func markdownDoc(e *Erd) string {
+	d := documentation(e)
+	var sb strings.Builder
+	code := func(s string) string { return "`" + s + "`" }
+	section := func(heading string) {
+		if sb.Len() > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(heading + "\n")
+	}
+
+	if d.Title != "" {
+		section("# " + d.Title)
+	}
+	for _, n := range d.Notes {
+		section(markdownParagraph(n))
+	}
+	for _, t := range d.Tables {
+		heading := "## " + t.Title
+		if t.Label != "" {
+			heading += " (" + t.Label + ")"
+		}
+		section(heading)
+		if t.Extends != "" {
+			section("extends " + code(t.Extends))
+		}
+		for _, n := range t.Notes {
+			section(markdownParagraph(n))
+		}
+		if len(t.Columns) == 0 {
+			continue
+		}
+		rows := []string{"| Column | Key | Type | Notes |", "| --- | --- | --- | --- |"}
+		for _, c := range t.Columns {
+			notes := c.Notes
+			if c.Label != "" {
+				notes = append([]string{c.Label}, notes...)
+			}
+			var cells []string
+			for _, n := range notes {
+				cells = append(cells, markdownCell(n))
+			}
+			typ := ""
+			if c.Type != "" {
+				typ = code(strings.Replace(c.Type, "|", `\|`, -1))
+			}
+			rows = append(rows, fmt.Sprintf("| %s | %s | %s | %s |", code(c.Name), c.Key, typ, strings.Join(cells, "<br>")))
+		}
+		section(strings.Join(rows, "\n"))
+	}
+	if len(d.Relations) > 0 {
+		var items []string
+		for _, r := range d.Relations {
+			items = append(items, "- "+code(r))
+		}
+		section("## Relations")
+		section(strings.Join(items, "\n"))
+	}
+	if len(d.Enums) > 0 {
+		var items []string
+		for _, en := range d.Enums {
+			items = append(items, "- "+code(en.Name)+": "+strings.Join(en.Values, ", "))
+		}
+		section("## Enums")
+		section(strings.Join(items, "\n"))
+	}
+	return sb.String()
+}
+
+// htmlDoc writes the documentation of the ERD as an HTML page
+func htmlDoc(e *Erd, w io.Writer) error {
+	page, _ := Asset("templates/doc.html")
+	t, err := template.New("").Funcs(template.FuncMap{
+		"join":  strings.Join,
+		"lines": func(s string) []string { return strings.Split(strings.TrimRight(s, "\n"), "\n") },
+	}).Parse(string(page))
+	if err != nil {
+		return err
+	}
+	return t.ExecuteTemplate(w, "doc", documentation(e))
+}
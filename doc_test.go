@@ -0,0 +1,73 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+const docSchema = `title {label: "League"}
+note "loaded nightly\nfrom the feed"
+
+[player] {note: "one row per player"}
+*id int not null
+name {label: "full name", note: "as printed | on the jersey"}
++team_id
+
+[team]
+*id
+
+player *--1 team
+note "a <b> & c" {attach: player.id}
+note "about teams" {attach: team}
+`
+
+func TestMarkdownDoc(t *testing.T) {
+	erd, err := parseErd(docSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "# League\n\n" +
+		"loaded nightly  \nfrom the feed\n\n" +
+		"## player\n\n" +
+		"one row per player\n\n" +
+		"| Column | Key | Type | Notes |\n" +
+		"| --- | --- | --- | --- |\n" +
+		"| `id` | PK | `int not null` | a &lt;b&gt; &amp; c |\n" +
+		"| `name` |  |  | full name<br>as printed \\| on the jersey |\n" +
+		"| `team_id` | FK |  |  |\n\n" +
+		"## team\n\n" +
+		"about teams\n\n" +
+		"| Column | Key | Type | Notes |\n" +
+		"| --- | --- | --- | --- |\n" +
+		"| `id` | PK |  |  |\n\n" +
+		"## Relations\n\n" +
+		"- `player *--1 team`\n"
+	if got := markdownDoc(erd); got != want {
+		t.Errorf("got:\n%v\nwant:\n%v", got, want)
+	}
+}
+
+func TestHtmlDoc(t *testing.T) {
+	erd, err := parseErd(docSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := htmlDoc(erd, &buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`<h1>League</h1>`,
+		`<p class="note">loaded nightly<br>from the feed</p>`,
+		`<p class="note">one row per player</p>`,
+		`<td><div>a &lt;b&gt; &amp; c</div></td>`,
+		`<div class="label">full name</div><div>as printed | on the jersey</div>`,
+		`<p class="note">about teams</p>`,
+		`<li><code>player *--1 team</code></li>`,
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("%q not found in\n%v", want, buf.String())
+		}
+	}
+}
@@ -2,6 +2,8 @@ package main
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -13,6 +15,7 @@ import (
 	"strings"
 	"syscall"
 	"text/template"
+	"time"
 
 	flags "github.com/jessevdk/go-flags"
 	"golang.org/x/crypto/ssh/terminal"
@@ -20,111 +23,540 @@ import (
 
 // Options for the command line tool
 type Options struct {
-	OutFormat  string `short:"f" long:"fmt" description:"output format (dot only)"`
-	InputFile  string `short:"i" long:"input" description:"input will be read from the given file."`
-	OutputFile string `short:"o" long:"output" description:"output will be written to the given file."`
+	OutFormat   string   `short:"f" long:"fmt" description:"output format passed to Graphviz dot, json for the model, or markdown or html for the documentation."`
+	InputFile   string   `short:"i" long:"input" description:"input will be read from the given file."`
+	OutputFile  string   `short:"o" long:"output" description:"output will be written to the given file (a directory with --split)."`
+	Split       bool     `long:"split" description:"render one output per input file instead of merging them."`
+	Focus       []string `long:"focus" description:"render only the tables around the given table (may be repeated)."`
+	Depth       int      `long:"depth" default:"1" description:"number of relation hops kept around the --focus tables."`
+	Include     []string `long:"include" description:"render only tables matching the given glob (may be repeated)."`
+	Exclude     []string `long:"exclude" description:"do not render tables matching the given glob (may be repeated)."`
+	Stubs       bool     `long:"stubs" description:"draw relations to tables left out by the filters as stubs."`
+	Notation    string   `long:"notation" default:"crowsfoot" choice:"crowsfoot" choice:"uml" choice:"chen" choice:"idef1x" choice:"minmax" description:"notation used to draw the relations."`
+	Columns     string   `long:"columns" default:"all" choice:"all" choice:"keys" choice:"none" description:"columns to draw in the tables: all, keys only or none."`
+	Rankdir     string   `long:"rankdir" choice:"TB" choice:"LR" choice:"BT" choice:"RL" description:"direction of the layout, overriding the graph directive (LR by default)."`
+	Splines     string   `long:"splines" choice:"spline" choice:"ortho" choice:"polyline" choice:"curved" choice:"line" choice:"none" description:"how the relations are drawn, overriding the graph directive (spline by default)."`
+	Font        string   `long:"font" description:"font name of the labels, overriding the graph directive."`
+	Concentrate bool     `long:"concentrate" description:"merge the lines of parallel relations."`
+	Theme       string   `long:"theme" description:"theme of the diagram: default, dark, monochrome, print, high-contrast, or a .json or .yaml theme file."`
+	Watch       bool     `long:"watch" description:"render again whenever an input file changes."`
+	TemplateDir string   `long:"template-dir" description:"directory of .tmpl files overriding or adding to the templates."`
+	Templates   []string `long:"template" value-name:"NAME=FILE" description:"override or add the named template (may be repeated)."`
 }
 
 var opts Options
 
-func main() {
-	contents := ""
-	logStderr := log.New(os.Stderr, "", 0)
+// input is the contents of a single .er source
+type input struct {
+	Name     string
+	Contents string
+}
+
+// exitStatus is returned by commands which only need to set the exit status
+type exitStatus int
+
+func (s exitStatus) Error() string {
+	return fmt.Sprintf("exit status %d", int(s))
+}
+
+var logStderr = log.New(os.Stderr, "", 0)
+
+// exit reports the error and terminates the program
+func exit(err error) {
+	if s, ok := err.(exitStatus); ok {
+		os.Exit(int(s))
+	}
+	if flagsErr, ok := err.(*flags.Error); ok && flagsErr.Type == flags.ErrHelp {
+		fmt.Fprintln(os.Stdout, err)
+		os.Exit(0)
+	}
+	logStderr.Println(err)
+	os.Exit(1)
+}
 
-	optsParser := flags.NewParser(&opts, flags.Default)
+func main() {
+	optsParser := flags.NewParser(&opts, flags.HelpFlag|flags.PassDoubleDash)
 	optsParser.Name = filepath.Base(os.Args[0])
-	optsParser.Usage = "[OPTIONS] PATTERN [PATH]"
+	optsParser.Usage = "[OPTIONS] [FILE|PATTERN]..."
+	optsParser.SubcommandsOptional = true
+	optsParser.AddCommand("fmt", "Format .er files",
+		"Rewrites .er files in the canonical layout, keeping comments and blank lines.",
+		&fmtCommand)
+	optsParser.AddCommand("lsp", "Run the language server",
+		"Speaks the Language Server Protocol over stdin and stdout.",
+		&lspCommand)
+	optsParser.AddCommand("serve", "Serve a live preview",
+		"Serves the diagram of the given files on a local web page which is updated when they change.",
+		&serveCommand)
+	optsParser.AddCommand("server", "Run the rendering API",
+		"Renders .er sources posted to /render/{format}, or encoded in GET /render/{format}/{source} like Kroki.",
+		&serverCommand)
+	optsParser.AddCommand("diff", "Compare two schemas",
+		"Reports the tables, columns, attributes and relations changed between two schemas. Exits with status 1 when they differ.",
+		&diffCommand)
+	optsParser.AddCommand("migrate", "Write SQL migrations",
+		"Writes the SQL statements migrating the old schema to the new one, using the type attributes of the columns and foreign keys derived from the relations.",
+		&migrateCommand)
 
 	args, err := optsParser.Parse()
 	if err != nil {
-		logStderr.Println(err)
-		os.Exit(1)
+		exit(err)
+	}
+	if optsParser.Active != nil {
+		return
+	}
+	t, err := templates(opts.Notation, opts.TemplateDir, opts.Templates)
+	if err != nil {
+		exit(err)
 	}
 
-	if terminal.IsTerminal(int(syscall.Stdin)) {
-		if len(args) == 0 && opts.InputFile == "" {
-			optsParser.WriteHelp(os.Stdout)
+	patterns := args
+	if opts.InputFile != "" {
+		patterns = append([]string{opts.InputFile}, patterns...)
+	}
+
+	if len(patterns) == 0 {
+		if opts.Watch {
+			logStderr.Println("--watch needs input files")
 			os.Exit(1)
 		}
-		buffer, err := ioutil.ReadFile(opts.InputFile)
-		if err != nil {
-			logStderr.Println(err)
+		if terminal.IsTerminal(int(syscall.Stdin)) {
+			optsParser.WriteHelp(os.Stdout)
 			os.Exit(1)
 		}
-		contents = string(buffer)
-	} else {
 		body, err := ioutil.ReadAll(os.Stdin)
+		if err == nil {
+			err = build([]input{{Name: "<stdin>", Contents: string(body)}}, t)
+		}
 		if err != nil {
 			logStderr.Println(err)
 			os.Exit(1)
 		}
-		contents = string(body)
+		return
 	}
 
-	parser := &Parser{Buffer: contents}
-	err = parser.Init()
+	if opts.Watch {
+		w := &watcher{Patterns: patterns, Interval: watchInterval, Debounce: watchDebounce}
+		w.Run(func() {
+			err := readAndBuild(patterns, t)
+			if err != nil {
+				logStderr.Println(err)
+				return
+			}
+			logStderr.Printf("%s rendered\n", time.Now().Format("15:04:05"))
+		}, nil)
+		return
+	}
+
+	err = readAndBuild(patterns, t)
 	if err != nil {
 		logStderr.Println(err)
 		os.Exit(1)
 	}
+}
+
+// readAndBuild reads the files matching the patterns and renders them
+func readAndBuild(patterns []string, t *template.Template) error {
+	inputs, err := readInputs(patterns)
+	if err != nil {
+		return err
+	}
+	return build(inputs, t)
+}
+
+// inputError is an error at a position of an input
+type inputError struct {
+	Name string
+	Position
+	Message string
+	Text    string // the line of the error
+}
+
+func (e *inputError) Error() string {
+	return fmt.Sprintf("%s:%v: %s", e.Name, e.Position, e.Message)
+}
+
+// inputErrors are the errors found in the inputs, reported together
+type inputErrors []*inputError
+
+func (e inputErrors) Error() string {
+	var lines []string
+	for _, err := range e {
+		lines = append(lines, err.Error())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// newInputError creates an error at the position of an input
+func newInputError(in input, pos Position, message string) *inputError {
+	start := strings.LastIndex(in.Contents[:pos.Offset], "\n") + 1
+	end := strings.IndexByte(in.Contents[start:], '\n')
+	if end < 0 {
+		end = len(in.Contents) - start
+	}
+	return &inputError{Name: in.Name, Position: pos, Message: message, Text: in.Contents[start : start+end]}
+}
+
+// check validates a single source, reporting its errors together.
+// The warnings are returned to be reported once all inputs are known.
+func check(in input) ([]Problem, error) {
+	f, err := ParseSyntax(in.Contents)
+	if err != nil {
+		if e, ok := err.(*SyntaxError); ok {
+			return nil, inputErrors{newInputError(in, e.Position, e.Message)}
+		}
+		return nil, fmt.Errorf("%s: %v", in.Name, err)
+	}
+	var warnings []Problem
+	var errors inputErrors
+	for _, p := range Validate(f) {
+		if p.Warning {
+			warnings = append(warnings, p)
+		} else {
+			errors = append(errors, newInputError(in, p.Begin, p.Message))
+		}
+	}
+	if len(errors) > 0 {
+		return nil, errors
+	}
+	return warnings, nil
+}
+
+// warn reports the warnings of an input, leaving out references to tables
+// which another input defines
+func warn(name string, warnings []Problem, erd *Erd) {
+	for _, p := range warnings {
+		if p.table != "" && erd.Tables[replaceAllIllegal(p.table)] != nil {
+			continue
+		}
+		logStderr.Printf("%s:%v\n", name, p)
+	}
+}
+
+// load checks and parses the inputs and merges them into one ERD
+func load(inputs []input) (*Erd, error) {
+	warnings := make([][]Problem, len(inputs))
+	erd := &Erd{}
+	for i, in := range inputs {
+		var err error
+		warnings[i], err = check(in)
+		if err != nil {
+			return nil, err
+		}
+		e, err := parseErd(in.Contents)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", in.Name, err)
+		}
+		err = erd.Merge(e)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", in.Name, err)
+		}
+	}
+	for i, in := range inputs {
+		warn(in.Name, warnings[i], erd)
+	}
+	return erd, nil
+}
+
+// build renders the inputs, merged or one by one with --split
+func build(inputs []input, t *template.Template) error {
+	if opts.Split && len(inputs) > 1 {
+		for _, in := range inputs {
+			erd, err := load([]input{in})
+			if err != nil {
+				return err
+			}
+			err = writeOutput(erd, splitOutputPath(in.Name), t)
+			if err != nil {
+				return fmt.Errorf("%s: %v", in.Name, err)
+			}
+		}
+		return nil
+	}
+
+	erd, err := load(inputs)
+	if err != nil {
+		return err
+	}
+	return writeOutput(erd, opts.OutputFile, t)
+}
+
+// readInputs expands the glob patterns and reads every matching file
+func readInputs(patterns []string) ([]input, error) {
+	var inputs []input
+	seen := map[string]bool{}
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+		if len(matches) == 0 {
+			if strings.ContainsAny(pattern, "*?[") {
+				return nil, fmt.Errorf("no files match %s", pattern)
+			}
+			// let ReadFile report the missing file
+			matches = []string{pattern}
+		}
+		for _, name := range matches {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+
+			buffer, err := ioutil.ReadFile(name)
+			if err != nil {
+				return nil, err
+			}
+			inputs = append(inputs, input{Name: name, Contents: string(buffer)})
+		}
+	}
+	return inputs, nil
+}
+
+// parseErd parses the contents of a single .er source
+func parseErd(contents string) (*Erd, error) {
+	parser := &Parser{Buffer: contents}
+	err := parser.Init()
+	if err != nil {
+		return nil, err
+	}
 	err = parser.Parse()
 	if err != nil {
-		logStderr.Println(err)
-		os.Exit(1)
+		return nil, err
 	}
 
 	parser.Execute()
 
 	if parser.Erd.IsError {
-		os.Exit(1)
+		return nil, fmt.Errorf("syntax error")
+	}
+	if err := parser.Erd.checkGroups(); err != nil {
+		return nil, err
+	}
+	return &parser.Erd, nil
+}
+
+// splitOutputPath returns the output file for an input in --split mode
+func splitOutputPath(name string) string {
+	ext := opts.OutFormat
+	if ext == "" {
+		ext = "dot"
+	}
+	base := strings.TrimSuffix(filepath.Base(name), filepath.Ext(name)) + "." + ext
+
+	dir := filepath.Dir(name)
+	if opts.OutputFile != "" {
+		dir = opts.OutputFile
+	}
+	return filepath.Join(dir, base)
+}
+
+// filtered applies the filters, the theme and the layout flags of the
+// options to the ERD
+func filtered(erd *Erd) (*Erd, error) {
+	erd, err := erd.Filter(Filter{
+		Focus:   opts.Focus,
+		Depth:   opts.Depth,
+		Include: opts.Include,
+		Exclude: opts.Exclude,
+		Stubs:   opts.Stubs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	erd.ColumnMode = opts.Columns
+
+	// the theme of the title names a built-in theme, --theme may load a file
+	if name := opts.Theme; name != "" {
+		erd.theme, err = loadTheme(name)
+	} else if name := erd.Title.TitleAttributes["theme"]; name != "" && !isThemeFile(name) {
+		erd.theme, err = loadTheme(name)
+	} else if name != "" {
+		err = fmt.Errorf("theme %q: theme files are only loaded with --theme", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// the layout flags override the graph directive
+	layout := map[string]string{
+		"rankdir":  opts.Rankdir,
+		"splines":  opts.Splines,
+		"fontname": opts.Font,
+	}
+	if opts.Concentrate {
+		layout["concentrate"] = "true"
+	}
+	attrs := map[string]string{}
+	for k, v := range erd.GraphAttributes {
+		attrs[k] = v
+	}
+	for name, v := range layout {
+		if v == "" {
+			continue
+		}
+		for k := range attrs {
+			if strings.HasSuffix(k, "."+name) {
+				delete(attrs, k)
+			}
+		}
+		attrs[name] = v
+	}
+	erd.GraphAttributes = attrs
+	return erd, nil
+}
+
+// writeOutput renders the ERD to the given file, or stdout when path is empty
+func writeOutput(erd *Erd, path string, t *template.Template) error {
+	erd, err := filtered(erd)
+	if err != nil {
+		return err
+	}
+
+	fd := os.Stdout
+	if path != "" {
+		err = os.MkdirAll(filepath.Dir(path), 0755)
+		if err != nil {
+			return err
+		}
+		fd, err = os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer fd.Close()
+	}
+	return renderFormat(context.Background(), erd, t, opts.OutFormat, fd)
+}
+
+// loadTemplates parses the embedded dot templates, drawing relations in the given notation
+func loadTemplates(notation string) *template.Template {
+	relationsName := "templates/dot_relations.tmpl"
+	if notation != "" && notation != "crowsfoot" {
+		relationsName = "templates/dot_relations_" + notation + ".tmpl"
 	}
-	parser.Erd.CalcIsolated()
 
 	dot, _ := Asset("templates/dot.tmpl")
 	tables, _ := Asset("templates/dot_tables.tmpl")
-	relations, _ := Asset("templates/dot_relations.tmpl")
-	templates := template.Must(
-		template.New("").Funcs(template.FuncMap{"StringsJoin": strings.Join}).Parse(
+	relations, _ := Asset(relationsName)
+	relationships, _ := Asset("templates/dot_relationships.tmpl")
+	subtypes, _ := Asset("templates/dot_subtypes.tmpl")
+	enums, _ := Asset("templates/dot_enums.tmpl")
+	notes, _ := Asset("templates/dot_notes.tmpl")
+	groups, _ := Asset("templates/dot_groups.tmpl")
+	return template.Must(
+		template.New("").Funcs(templateFuncs).Parse(
 			string(dot) +
 				string(tables) +
-				string(relations)))
+				string(relations) +
+				string(relationships) +
+				string(subtypes) +
+				string(enums) +
+				string(notes) +
+				string(groups)))
+}
 
-	fd := os.Stdout
-	if opts.OutputFile != "" {
-		fd, err = os.Create(opts.OutputFile)
+// parseTemplate adds the template in the file, which replaces the
+// templates of the same names. A file without {{define}} is named name.
+func parseTemplate(t *template.Template, name, path string) error {
+	body, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	_, err = t.New(name).Parse(string(body))
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	return nil
+}
+
+// templates returns the embedded templates of the notation overridden by
+// the files of dir, named after the files, and then by the NAME=FILE
+// overrides. They are parsed once and shared by every render.
+func templates(notation, dir string, overrides []string) (*template.Template, error) {
+	t := loadTemplates(notation)
+	if dir != "" {
+		files, err := filepath.Glob(filepath.Join(dir, "*.tmpl"))
 		if err != nil {
-			logStderr.Println(err)
-			os.Exit(1)
+			return nil, err
+		}
+		if len(files) == 0 {
+			return nil, fmt.Errorf("no .tmpl files in %s", dir)
+		}
+		for _, f := range files {
+			err = parseTemplate(t, strings.TrimSuffix(filepath.Base(f), ".tmpl"), f)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, spec := range overrides {
+		i := strings.Index(spec, "=")
+		if i <= 0 {
+			return nil, fmt.Errorf("--template %s: want NAME=FILE", spec)
+		}
+		err := parseTemplate(t, spec[:i], spec[i+1:])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// renderFormat writes the ERD in the given format: the dot source of the
+// templates t when format is empty, the model for json, the documentation
+// for markdown and html, and anything else through Graphviz, which is
+// killed when the context is done
+func renderFormat(ctx context.Context, erd *Erd, t *template.Template, format string, w io.Writer) error {
+	switch format {
+	case "json":
+		body, err := json.MarshalIndent(erd, "", "  ")
+		if err != nil {
+			return err
 		}
+		_, err = w.Write(append(body, '\n'))
+		return err
+	case "markdown":
+		_, err := io.WriteString(w, markdownDoc(erd))
+		return err
+	case "html":
+		return htmlDoc(erd, w)
 	}
 
+	erd.CalcIsolated()
+
 	var erdbuf bytes.Buffer
-	err = templates.ExecuteTemplate(&erdbuf, "dot", parser.Erd)
+	err := t.ExecuteTemplate(&erdbuf, "dot", erd)
 	if err != nil {
-		logStderr.Println(err)
-		os.Exit(1)
+		return err
 	}
 
 	// The OutFormat only works with Graphviz together
-	if opts.OutFormat != "" {
+	if format != "" {
 		dotcmd := "dot"
 		if runtime.GOOS == "windows" {
 			dotcmd = "dot.exe"
 		}
-		cmd := exec.Command(dotcmd, fmt.Sprintf("-T%s", opts.OutFormat))
+		cmd := exec.CommandContext(ctx, dotcmd, fmt.Sprintf("-T%s", format))
+		var stderr bytes.Buffer
 		cmd.Stdin = &erdbuf
-		cmd.Stdout = fd
-		cmd.Stderr = fd
+		cmd.Stdout = w
+		cmd.Stderr = &stderr
 		err = cmd.Run()
-		if err != nil {
-			logStderr.Println(err)
-			os.Exit(1)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil && stderr.Len() > 0 {
+			return fmt.Errorf("%s: %v: %s", dotcmd, err, strings.TrimSpace(stderr.String()))
 		}
-	} else {
-		n, err := io.Copy(fd, &erdbuf)
 		if err != nil {
-			logStderr.Printf("failed to copy buffer: err: %v, copied %d bytes\n", err, n)
-			os.Exit(1)
+			return fmt.Errorf("%s: %v", dotcmd, err)
 		}
+		return nil
+	}
+
+	n, err := io.Copy(w, &erdbuf)
+	if err != nil {
+		return fmt.Errorf("failed to copy buffer: err: %v, copied %d bytes", err, n)
 	}
+	return nil
 }
@@ -25,20 +25,53 @@ const (
 	rulecomment_line
 	rulecolor_info
 	rulecolor_key_value
+	rulegroup_info
+	rulegroup_title
+	rulegroup_member
+	ruleenum_info
+	ruleenum_title
+	ruleenum_value
+	rulenote_info
+	rulenote_text
 	ruletitle_info
+	rulegraph_info
 	ruletable_info
 	ruletable_title
+	ruletable_parent
 	ruletable_column
 	rulecolumn_name
+	rulecolumn_definition
+	rulecolumn_type
+	ruletype_word
+	ruleconstraint_keyword
+	rulecolumn_constraint
+	rulecolumn_not_null
+	rulecolumn_null
+	rulecolumn_unique
+	rulecolumn_default
+	ruledefault_value
+	rulecolumn_check
+	rulecheck_condition
+	ruleparenthesized
 	rulerelation_info
 	rulerelation_left
 	rulecardinality_left
 	rulerelation_right
 	rulecardinality_right
+	rulerelation_operator
+	rulerelationship_info
+	rulerelationship_title
+	rulerelationship_participant
+	ruleparticipant_table
+	ruleparticipant_cardinality
 	ruletitle_attribute
+	rulegraph_attribute
 	ruletable_attribute
 	rulecolumn_attribute
+	rulegroup_attribute
 	rulerelation_attribute
+	rulenote_attribute
+	rulerelationship_attribute
 	ruleattribute_key
 	ruleattribute_value
 	rulebare_value
@@ -71,6 +104,26 @@ const (
 	ruleAction15
 	ruleAction16
 	ruleAction17
+	ruleAction18
+	ruleAction19
+	ruleAction20
+	ruleAction21
+	ruleAction22
+	ruleAction23
+	ruleAction24
+	ruleAction25
+	ruleAction26
+	ruleAction27
+	ruleAction28
+	ruleAction29
+	ruleAction30
+	ruleAction31
+	ruleAction32
+	ruleAction33
+	ruleAction34
+	ruleAction35
+	ruleAction36
+	ruleAction37
 )
 
 var rul3s = [...]string{
@@ -82,20 +135,53 @@ var rul3s = [...]string{
 	"comment_line",
 	"color_info",
 	"color_key_value",
+	"group_info",
+	"group_title",
+	"group_member",
+	"enum_info",
+	"enum_title",
+	"enum_value",
+	"note_info",
+	"note_text",
 	"title_info",
+	"graph_info",
 	"table_info",
 	"table_title",
+	"table_parent",
 	"table_column",
 	"column_name",
+	"column_definition",
+	"column_type",
+	"type_word",
+	"constraint_keyword",
+	"column_constraint",
+	"column_not_null",
+	"column_null",
+	"column_unique",
+	"column_default",
+	"default_value",
+	"column_check",
+	"check_condition",
+	"parenthesized",
 	"relation_info",
 	"relation_left",
 	"cardinality_left",
 	"relation_right",
 	"cardinality_right",
+	"relation_operator",
+	"relationship_info",
+	"relationship_title",
+	"relationship_participant",
+	"participant_table",
+	"participant_cardinality",
 	"title_attribute",
+	"graph_attribute",
 	"table_attribute",
 	"column_attribute",
+	"group_attribute",
 	"relation_attribute",
+	"note_attribute",
+	"relationship_attribute",
 	"attribute_key",
 	"attribute_value",
 	"bare_value",
@@ -128,6 +214,26 @@ var rul3s = [...]string{
 	"Action15",
 	"Action16",
 	"Action17",
+	"Action18",
+	"Action19",
+	"Action20",
+	"Action21",
+	"Action22",
+	"Action23",
+	"Action24",
+	"Action25",
+	"Action26",
+	"Action27",
+	"Action28",
+	"Action29",
+	"Action30",
+	"Action31",
+	"Action32",
+	"Action33",
+	"Action34",
+	"Action35",
+	"Action36",
+	"Action37",
 }
 
 type token32 struct {
@@ -244,7 +350,7 @@ type Parser struct {
 
 	Buffer string
 	buffer []rune
-	rules  [54]func() bool
+	rules  [107]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -355,32 +461,72 @@ func (p *Parser) Execute() {
 		case ruleAction3:
 			p.AddColorDefine()
 		case ruleAction4:
-			p.AddTable(text)
+			p.AddGroup(text)
 		case ruleAction5:
-			p.AddColumn(text)
+			p.AddGroupMember(text)
 		case ruleAction6:
-			p.AddRelation()
+			p.AddEnum(text)
 		case ruleAction7:
-			p.SetRelationLeft(text)
+			p.AddEnumValue(text)
 		case ruleAction8:
-			p.SetCardinalityLeft(text)
+			p.AddNote(text)
 		case ruleAction9:
-			p.SetRelationRight(text)
+			p.AddTable(text)
 		case ruleAction10:
-			p.SetCardinalityRight(text)
+			p.SetTableParent(text)
 		case ruleAction11:
-			p.AddTitleKeyValue()
+			p.AddColumn(text)
 		case ruleAction12:
-			p.AddTableKeyValue()
+			p.SetColumnType(text)
 		case ruleAction13:
-			p.AddColumnKeyValue()
+			p.SetColumnNotNull(true)
 		case ruleAction14:
-			p.AddRelationKeyValue()
+			p.SetColumnNotNull(false)
 		case ruleAction15:
-			p.SetKey(text)
+			p.SetColumnUnique()
 		case ruleAction16:
-			p.SetValue(text)
+			p.SetColumnDefault(text)
 		case ruleAction17:
+			p.SetColumnCheck(text)
+		case ruleAction18:
+			p.AddRelation()
+		case ruleAction19:
+			p.SetRelationLeft(text)
+		case ruleAction20:
+			p.SetCardinalityLeft(text)
+		case ruleAction21:
+			p.SetRelationRight(text)
+		case ruleAction22:
+			p.SetCardinalityRight(text)
+		case ruleAction23:
+			p.SetRelationOperator(text)
+		case ruleAction24:
+			p.AddRelationship(text)
+		case ruleAction25:
+			p.SetParticipant(text)
+		case ruleAction26:
+			p.AddParticipant(text)
+		case ruleAction27:
+			p.AddTitleKeyValue()
+		case ruleAction28:
+			p.AddGraphKeyValue()
+		case ruleAction29:
+			p.AddTableKeyValue()
+		case ruleAction30:
+			p.AddColumnKeyValue()
+		case ruleAction31:
+			p.AddGroupKeyValue()
+		case ruleAction32:
+			p.AddRelationKeyValue()
+		case ruleAction33:
+			p.AddNoteKeyValue()
+		case ruleAction34:
+			p.AddRelationshipKeyValue()
+		case ruleAction35:
+			p.SetKey(text)
+		case ruleAction36:
+			p.SetValue(text)
+		case ruleAction37:
 			p.SetValue(text)
 
 		}
@@ -465,6 +611,14 @@ func (p *Parser) Init(options ...func(*Parser) error) error {
 		return false
 	}*/
 
+	/*matchRange := func(lower byte, upper byte) bool {
+		if c := buffer[position]; c >= lower && c <= upper {
+			position++
+			return true
+		}
+		return false
+	}*/
+
 	_rules = [...]func() bool{
 		nil,
 		/* 0 root <- <((expression EOT) / (expression <.+> Action0 EOT) / (<.+> Action1 EOT))> */
@@ -565,7 +719,7 @@ func (p *Parser) Init(options ...func(*Parser) error) error {
 			position, tokenIndex = position11, tokenIndex11
 			return false
 		},
-		/* 2 expression <- <(title_info / color_info / relation_info / table_info / comment_line / empty_line)*> */
+		/* 2 expression <- <(title_info / graph_info / color_info / group_info / enum_info / note_info / relationship_info / relation_info / table_info / comment_line / empty_line)*> */
 		func() bool {
 			{
 				position15 := position
@@ -580,29 +734,59 @@ func (p *Parser) Init(options ...func(*Parser) error) error {
 						goto l18
 					l19:
 						position, tokenIndex = position18, tokenIndex18
-						if !_rules[rulecolor_info]() {
+						if !_rules[rulegraph_info]() {
 							goto l20
 						}
 						goto l18
 					l20:
 						position, tokenIndex = position18, tokenIndex18
-						if !_rules[rulerelation_info]() {
+						if !_rules[rulecolor_info]() {
 							goto l21
 						}
 						goto l18
 					l21:
 						position, tokenIndex = position18, tokenIndex18
-						if !_rules[ruletable_info]() {
+						if !_rules[rulegroup_info]() {
 							goto l22
 						}
 						goto l18
 					l22:
 						position, tokenIndex = position18, tokenIndex18
-						if !_rules[rulecomment_line]() {
+						if !_rules[ruleenum_info]() {
 							goto l23
 						}
 						goto l18
 					l23:
+						position, tokenIndex = position18, tokenIndex18
+						if !_rules[rulenote_info]() {
+							goto l24
+						}
+						goto l18
+					l24:
+						position, tokenIndex = position18, tokenIndex18
+						if !_rules[rulerelationship_info]() {
+							goto l25
+						}
+						goto l18
+					l25:
+						position, tokenIndex = position18, tokenIndex18
+						if !_rules[rulerelation_info]() {
+							goto l26
+						}
+						goto l18
+					l26:
+						position, tokenIndex = position18, tokenIndex18
+						if !_rules[ruletable_info]() {
+							goto l27
+						}
+						goto l18
+					l27:
+						position, tokenIndex = position18, tokenIndex18
+						if !_rules[rulecomment_line]() {
+							goto l28
+						}
+						goto l18
+					l28:
 						position, tokenIndex = position18, tokenIndex18
 						if !_rules[ruleempty_line]() {
 							goto l17
@@ -619,555 +803,558 @@ func (p *Parser) Init(options ...func(*Parser) error) error {
 		},
 		/* 3 empty_line <- <(ws Action2)> */
 		func() bool {
-			position24, tokenIndex24 := position, tokenIndex
+			position29, tokenIndex29 := position, tokenIndex
 			{
-				position25 := position
+				position30 := position
 				if !_rules[rulews]() {
-					goto l24
+					goto l29
 				}
 				if !_rules[ruleAction2]() {
-					goto l24
+					goto l29
 				}
-				add(ruleempty_line, position25)
+				add(ruleempty_line, position30)
 			}
 			return true
-		l24:
-			position, tokenIndex = position24, tokenIndex24
+		l29:
+			position, tokenIndex = position29, tokenIndex29
 			return false
 		},
 		/* 4 comment_line <- <(space* '#' comment_string newline)> */
 		func() bool {
-			position26, tokenIndex26 := position, tokenIndex
+			position31, tokenIndex31 := position, tokenIndex
 			{
-				position27 := position
-			l28:
+				position32 := position
+			l33:
 				{
-					position29, tokenIndex29 := position, tokenIndex
+					position34, tokenIndex34 := position, tokenIndex
 					if !_rules[rulespace]() {
-						goto l29
+						goto l34
 					}
-					goto l28
-				l29:
-					position, tokenIndex = position29, tokenIndex29
+					goto l33
+				l34:
+					position, tokenIndex = position34, tokenIndex34
 				}
 				if buffer[position] != rune('#') {
-					goto l26
+					goto l31
 				}
 				position++
 				if !_rules[rulecomment_string]() {
-					goto l26
+					goto l31
 				}
 				if !_rules[rulenewline]() {
-					goto l26
+					goto l31
 				}
-				add(rulecomment_line, position27)
+				add(rulecomment_line, position32)
 			}
 			return true
-		l26:
-			position, tokenIndex = position26, tokenIndex26
+		l31:
+			position, tokenIndex = position31, tokenIndex31
 			return false
 		},
 		/* 5 color_info <- <('c' 'o' 'l' 'o' 'r' 's' ws* '{' ws* (color_key_value ws* attribute_sep? ws*)* ws* '}' newline)> */
 		func() bool {
-			position30, tokenIndex30 := position, tokenIndex
+			position35, tokenIndex35 := position, tokenIndex
 			{
-				position31 := position
+				position36 := position
 				if buffer[position] != rune('c') {
-					goto l30
+					goto l35
 				}
 				position++
 				if buffer[position] != rune('o') {
-					goto l30
+					goto l35
 				}
 				position++
 				if buffer[position] != rune('l') {
-					goto l30
+					goto l35
 				}
 				position++
 				if buffer[position] != rune('o') {
-					goto l30
+					goto l35
 				}
 				position++
 				if buffer[position] != rune('r') {
-					goto l30
+					goto l35
 				}
 				position++
 				if buffer[position] != rune('s') {
-					goto l30
+					goto l35
 				}
 				position++
-			l32:
+			l37:
 				{
-					position33, tokenIndex33 := position, tokenIndex
+					position38, tokenIndex38 := position, tokenIndex
 					if !_rules[rulews]() {
-						goto l33
+						goto l38
 					}
-					goto l32
-				l33:
-					position, tokenIndex = position33, tokenIndex33
+					goto l37
+				l38:
+					position, tokenIndex = position38, tokenIndex38
 				}
 				if buffer[position] != rune('{') {
-					goto l30
+					goto l35
 				}
 				position++
-			l34:
+			l39:
 				{
-					position35, tokenIndex35 := position, tokenIndex
+					position40, tokenIndex40 := position, tokenIndex
 					if !_rules[rulews]() {
-						goto l35
+						goto l40
 					}
-					goto l34
-				l35:
-					position, tokenIndex = position35, tokenIndex35
+					goto l39
+				l40:
+					position, tokenIndex = position40, tokenIndex40
 				}
-			l36:
+			l41:
 				{
-					position37, tokenIndex37 := position, tokenIndex
+					position42, tokenIndex42 := position, tokenIndex
 					if !_rules[rulecolor_key_value]() {
-						goto l37
+						goto l42
 					}
-				l38:
+				l43:
 					{
-						position39, tokenIndex39 := position, tokenIndex
+						position44, tokenIndex44 := position, tokenIndex
 						if !_rules[rulews]() {
-							goto l39
+							goto l44
 						}
-						goto l38
-					l39:
-						position, tokenIndex = position39, tokenIndex39
+						goto l43
+					l44:
+						position, tokenIndex = position44, tokenIndex44
 					}
 					{
-						position40, tokenIndex40 := position, tokenIndex
+						position45, tokenIndex45 := position, tokenIndex
 						if !_rules[ruleattribute_sep]() {
-							goto l40
+							goto l45
 						}
-						goto l41
-					l40:
-						position, tokenIndex = position40, tokenIndex40
+						goto l46
+					l45:
+						position, tokenIndex = position45, tokenIndex45
 					}
-				l41:
-				l42:
+				l46:
+				l47:
 					{
-						position43, tokenIndex43 := position, tokenIndex
+						position48, tokenIndex48 := position, tokenIndex
 						if !_rules[rulews]() {
-							goto l43
+							goto l48
 						}
-						goto l42
-					l43:
-						position, tokenIndex = position43, tokenIndex43
+						goto l47
+					l48:
+						position, tokenIndex = position48, tokenIndex48
 					}
-					goto l36
-				l37:
-					position, tokenIndex = position37, tokenIndex37
+					goto l41
+				l42:
+					position, tokenIndex = position42, tokenIndex42
 				}
-			l44:
+			l49:
 				{
-					position45, tokenIndex45 := position, tokenIndex
+					position50, tokenIndex50 := position, tokenIndex
 					if !_rules[rulews]() {
-						goto l45
+						goto l50
 					}
-					goto l44
-				l45:
-					position, tokenIndex = position45, tokenIndex45
+					goto l49
+				l50:
+					position, tokenIndex = position50, tokenIndex50
 				}
 				if buffer[position] != rune('}') {
-					goto l30
+					goto l35
 				}
 				position++
 				if !_rules[rulenewline]() {
-					goto l30
+					goto l35
 				}
-				add(rulecolor_info, position31)
+				add(rulecolor_info, position36)
 			}
 			return true
-		l30:
-			position, tokenIndex = position30, tokenIndex30
+		l35:
+			position, tokenIndex = position35, tokenIndex35
 			return false
 		},
 		/* 6 color_key_value <- <(attribute_key space* ':' space* attribute_value Action3)> */
 		func() bool {
-			position46, tokenIndex46 := position, tokenIndex
+			position51, tokenIndex51 := position, tokenIndex
 			{
-				position47 := position
+				position52 := position
 				if !_rules[ruleattribute_key]() {
-					goto l46
+					goto l51
 				}
-			l48:
+			l53:
 				{
-					position49, tokenIndex49 := position, tokenIndex
+					position54, tokenIndex54 := position, tokenIndex
 					if !_rules[rulespace]() {
-						goto l49
+						goto l54
 					}
-					goto l48
-				l49:
-					position, tokenIndex = position49, tokenIndex49
+					goto l53
+				l54:
+					position, tokenIndex = position54, tokenIndex54
 				}
 				if buffer[position] != rune(':') {
-					goto l46
+					goto l51
 				}
 				position++
-			l50:
+			l55:
 				{
-					position51, tokenIndex51 := position, tokenIndex
+					position56, tokenIndex56 := position, tokenIndex
 					if !_rules[rulespace]() {
-						goto l51
+						goto l56
 					}
-					goto l50
-				l51:
-					position, tokenIndex = position51, tokenIndex51
+					goto l55
+				l56:
+					position, tokenIndex = position56, tokenIndex56
 				}
 				if !_rules[ruleattribute_value]() {
-					goto l46
+					goto l51
 				}
 				if !_rules[ruleAction3]() {
-					goto l46
+					goto l51
 				}
-				add(rulecolor_key_value, position47)
+				add(rulecolor_key_value, position52)
 			}
 			return true
-		l46:
-			position, tokenIndex = position46, tokenIndex46
+		l51:
+			position, tokenIndex = position51, tokenIndex51
 			return false
 		},
-		/* 7 title_info <- <('t' 'i' 't' 'l' 'e' ws* '{' ws* (title_attribute ws* attribute_sep? ws*)* ws* '}' newline)> */
+		/* 7 group_info <- <('g' 'r' 'o' 'u' 'p' space+ group_title (space* '{' ws* (group_attribute ws* attribute_sep? ws*)* ws* '}')? ws* '{' ws* (group_member ws* attribute_sep? ws*)* ws* '}' newline_or_eot)> */
 		func() bool {
-			position52, tokenIndex52 := position, tokenIndex
+			position57, tokenIndex57 := position, tokenIndex
 			{
-				position53 := position
-				if buffer[position] != rune('t') {
-					goto l52
+				position58 := position
+				if buffer[position] != rune('g') {
+					goto l57
 				}
 				position++
-				if buffer[position] != rune('i') {
-					goto l52
-				}
-				position++
-				if buffer[position] != rune('t') {
-					goto l52
+				if buffer[position] != rune('r') {
+					goto l57
 				}
 				position++
-				if buffer[position] != rune('l') {
-					goto l52
+				if buffer[position] != rune('o') {
+					goto l57
 				}
 				position++
-				if buffer[position] != rune('e') {
-					goto l52
+				if buffer[position] != rune('u') {
+					goto l57
 				}
 				position++
-			l54:
-				{
-					position55, tokenIndex55 := position, tokenIndex
-					if !_rules[rulews]() {
-						goto l55
-					}
-					goto l54
-				l55:
-					position, tokenIndex = position55, tokenIndex55
-				}
-				if buffer[position] != rune('{') {
-					goto l52
+				if buffer[position] != rune('p') {
+					goto l57
 				}
 				position++
-			l56:
-				{
-					position57, tokenIndex57 := position, tokenIndex
-					if !_rules[rulews]() {
-						goto l57
-					}
-					goto l56
-				l57:
-					position, tokenIndex = position57, tokenIndex57
+				if !_rules[rulespace]() {
+					goto l57
 				}
-			l58:
+			l59:
 				{
-					position59, tokenIndex59 := position, tokenIndex
-					if !_rules[ruletitle_attribute]() {
-						goto l59
-					}
-				l60:
-					{
-						position61, tokenIndex61 := position, tokenIndex
-						if !_rules[rulews]() {
-							goto l61
-						}
+					position60, tokenIndex60 := position, tokenIndex
+					if !_rules[rulespace]() {
 						goto l60
-					l61:
-						position, tokenIndex = position61, tokenIndex61
-					}
-					{
-						position62, tokenIndex62 := position, tokenIndex
-						if !_rules[ruleattribute_sep]() {
-							goto l62
-						}
-						goto l63
-					l62:
-						position, tokenIndex = position62, tokenIndex62
-					}
-				l63:
-				l64:
-					{
-						position65, tokenIndex65 := position, tokenIndex
-						if !_rules[rulews]() {
-							goto l65
-						}
-						goto l64
-					l65:
-						position, tokenIndex = position65, tokenIndex65
-					}
-					goto l58
-				l59:
-					position, tokenIndex = position59, tokenIndex59
-				}
-			l66:
-				{
-					position67, tokenIndex67 := position, tokenIndex
-					if !_rules[rulews]() {
-						goto l67
 					}
-					goto l66
-				l67:
-					position, tokenIndex = position67, tokenIndex67
-				}
-				if buffer[position] != rune('}') {
-					goto l52
-				}
-				position++
-				if !_rules[rulenewline]() {
-					goto l52
-				}
-				add(ruletitle_info, position53)
-			}
-			return true
-		l52:
-			position, tokenIndex = position52, tokenIndex52
-			return false
-		},
-		/* 8 table_info <- <('[' table_title ']' (space* '{' ws* (table_attribute ws* attribute_sep?)* ws* '}' space*)? newline_or_eot (table_column / empty_line)*)> */
-		func() bool {
-			position68, tokenIndex68 := position, tokenIndex
-			{
-				position69 := position
-				if buffer[position] != rune('[') {
-					goto l68
-				}
-				position++
-				if !_rules[ruletable_title]() {
-					goto l68
+					goto l59
+				l60:
+					position, tokenIndex = position60, tokenIndex60
 				}
-				if buffer[position] != rune(']') {
-					goto l68
+				if !_rules[rulegroup_title]() {
+					goto l57
 				}
-				position++
 				{
-					position70, tokenIndex70 := position, tokenIndex
-				l72:
+					position61, tokenIndex61 := position, tokenIndex
+				l63:
 					{
-						position73, tokenIndex73 := position, tokenIndex
+						position64, tokenIndex64 := position, tokenIndex
 						if !_rules[rulespace]() {
-							goto l73
+							goto l64
 						}
-						goto l72
-					l73:
-						position, tokenIndex = position73, tokenIndex73
+						goto l63
+					l64:
+						position, tokenIndex = position64, tokenIndex64
 					}
 					if buffer[position] != rune('{') {
-						goto l70
+						goto l61
 					}
 					position++
-				l74:
+				l65:
 					{
-						position75, tokenIndex75 := position, tokenIndex
+						position66, tokenIndex66 := position, tokenIndex
 						if !_rules[rulews]() {
-							goto l75
+							goto l66
 						}
-						goto l74
-					l75:
-						position, tokenIndex = position75, tokenIndex75
+						goto l65
+					l66:
+						position, tokenIndex = position66, tokenIndex66
 					}
-				l76:
+				l67:
 					{
-						position77, tokenIndex77 := position, tokenIndex
-						if !_rules[ruletable_attribute]() {
-							goto l77
+						position68, tokenIndex68 := position, tokenIndex
+						if !_rules[rulegroup_attribute]() {
+							goto l68
 						}
-					l78:
+					l69:
 						{
-							position79, tokenIndex79 := position, tokenIndex
+							position70, tokenIndex70 := position, tokenIndex
 							if !_rules[rulews]() {
-								goto l79
+								goto l70
 							}
-							goto l78
-						l79:
-							position, tokenIndex = position79, tokenIndex79
+							goto l69
+						l70:
+							position, tokenIndex = position70, tokenIndex70
 						}
 						{
-							position80, tokenIndex80 := position, tokenIndex
+							position71, tokenIndex71 := position, tokenIndex
 							if !_rules[ruleattribute_sep]() {
-								goto l80
+								goto l71
 							}
-							goto l81
-						l80:
-							position, tokenIndex = position80, tokenIndex80
+							goto l72
+						l71:
+							position, tokenIndex = position71, tokenIndex71
 						}
-					l81:
-						goto l76
-					l77:
-						position, tokenIndex = position77, tokenIndex77
+					l72:
+					l73:
+						{
+							position74, tokenIndex74 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l74
+							}
+							goto l73
+						l74:
+							position, tokenIndex = position74, tokenIndex74
+						}
+						goto l67
+					l68:
+						position, tokenIndex = position68, tokenIndex68
 					}
-				l82:
+				l75:
 					{
-						position83, tokenIndex83 := position, tokenIndex
+						position76, tokenIndex76 := position, tokenIndex
 						if !_rules[rulews]() {
-							goto l83
+							goto l76
 						}
-						goto l82
-					l83:
-						position, tokenIndex = position83, tokenIndex83
+						goto l75
+					l76:
+						position, tokenIndex = position76, tokenIndex76
 					}
 					if buffer[position] != rune('}') {
-						goto l70
+						goto l61
 					}
 					position++
-				l84:
+					goto l62
+				l61:
+					position, tokenIndex = position61, tokenIndex61
+				}
+			l62:
+			l77:
+				{
+					position78, tokenIndex78 := position, tokenIndex
+					if !_rules[rulews]() {
+						goto l78
+					}
+					goto l77
+				l78:
+					position, tokenIndex = position78, tokenIndex78
+				}
+				if buffer[position] != rune('{') {
+					goto l57
+				}
+				position++
+			l79:
+				{
+					position80, tokenIndex80 := position, tokenIndex
+					if !_rules[rulews]() {
+						goto l80
+					}
+					goto l79
+				l80:
+					position, tokenIndex = position80, tokenIndex80
+				}
+			l81:
+				{
+					position82, tokenIndex82 := position, tokenIndex
+					if !_rules[rulegroup_member]() {
+						goto l82
+					}
+				l83:
+					{
+						position84, tokenIndex84 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l84
+						}
+						goto l83
+					l84:
+						position, tokenIndex = position84, tokenIndex84
+					}
 					{
 						position85, tokenIndex85 := position, tokenIndex
-						if !_rules[rulespace]() {
+						if !_rules[ruleattribute_sep]() {
 							goto l85
 						}
-						goto l84
+						goto l86
 					l85:
 						position, tokenIndex = position85, tokenIndex85
 					}
-					goto l71
-				l70:
-					position, tokenIndex = position70, tokenIndex70
+				l86:
+				l87:
+					{
+						position88, tokenIndex88 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l88
+						}
+						goto l87
+					l88:
+						position, tokenIndex = position88, tokenIndex88
+					}
+					goto l81
+				l82:
+					position, tokenIndex = position82, tokenIndex82
+				}
+			l89:
+				{
+					position90, tokenIndex90 := position, tokenIndex
+					if !_rules[rulews]() {
+						goto l90
+					}
+					goto l89
+				l90:
+					position, tokenIndex = position90, tokenIndex90
+				}
+				if buffer[position] != rune('}') {
+					goto l57
 				}
-			l71:
+				position++
 				if !_rules[rulenewline_or_eot]() {
-					goto l68
+					goto l57
 				}
-			l86:
+				add(rulegroup_info, position58)
+			}
+			return true
+		l57:
+			position, tokenIndex = position57, tokenIndex57
+			return false
+		},
+		/* 8 group_title <- <(<(('"' string_in_quote '"') / string)> Action4)> */
+		func() bool {
+			position91, tokenIndex91 := position, tokenIndex
+			{
+				position92 := position
 				{
-					position87, tokenIndex87 := position, tokenIndex
+					position93 := position
 					{
-						position88, tokenIndex88 := position, tokenIndex
-						if !_rules[ruletable_column]() {
-							goto l89
+						position94, tokenIndex94 := position, tokenIndex
+						if buffer[position] != rune('"') {
+							goto l95
 						}
-						goto l88
-					l89:
-						position, tokenIndex = position88, tokenIndex88
-						if !_rules[ruleempty_line]() {
-							goto l87
+						position++
+						if !_rules[rulestring_in_quote]() {
+							goto l95
+						}
+						if buffer[position] != rune('"') {
+							goto l95
+						}
+						position++
+						goto l94
+					l95:
+						position, tokenIndex = position94, tokenIndex94
+						if !_rules[rulestring]() {
+							goto l91
 						}
 					}
-				l88:
-					goto l86
-				l87:
-					position, tokenIndex = position87, tokenIndex87
+				l94:
+					add(rulePegText, position93)
+				}
+				if !_rules[ruleAction4]() {
+					goto l91
 				}
-				add(ruletable_info, position69)
+				add(rulegroup_title, position92)
 			}
 			return true
-		l68:
-			position, tokenIndex = position68, tokenIndex68
+		l91:
+			position, tokenIndex = position91, tokenIndex91
 			return false
 		},
-		/* 9 table_title <- <(<string> Action4)> */
+		/* 9 group_member <- <(<string> Action5)> */
 		func() bool {
-			position90, tokenIndex90 := position, tokenIndex
+			position96, tokenIndex96 := position, tokenIndex
 			{
-				position91 := position
+				position97 := position
 				{
-					position92 := position
+					position98 := position
 					if !_rules[rulestring]() {
-						goto l90
+						goto l96
 					}
-					add(rulePegText, position92)
+					add(rulePegText, position98)
 				}
-				if !_rules[ruleAction4]() {
-					goto l90
+				if !_rules[ruleAction5]() {
+					goto l96
 				}
-				add(ruletable_title, position91)
+				add(rulegroup_member, position97)
 			}
 			return true
-		l90:
-			position, tokenIndex = position90, tokenIndex90
+		l96:
+			position, tokenIndex = position96, tokenIndex96
 			return false
 		},
-		/* 10 table_column <- <(space* column_name (space* '{' ws* (column_attribute ws* attribute_sep?)* ws* '}' space*)? newline_or_eot)> */
+		/* 10 enum_info <- <('e' 'n' 'u' 'm' space+ enum_title ws* '{' ws* (enum_value ws* attribute_sep? ws*)* ws* '}' newline_or_eot)> */
 		func() bool {
-			position93, tokenIndex93 := position, tokenIndex
+			position99, tokenIndex99 := position, tokenIndex
 			{
-				position94 := position
-			l95:
+				position100 := position
+				if buffer[position] != rune('e') {
+					goto l99
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l99
+				}
+				position++
+				if buffer[position] != rune('u') {
+					goto l99
+				}
+				position++
+				if buffer[position] != rune('m') {
+					goto l99
+				}
+				position++
+				if !_rules[rulespace]() {
+					goto l99
+				}
+			l101:
 				{
-					position96, tokenIndex96 := position, tokenIndex
+					position102, tokenIndex102 := position, tokenIndex
 					if !_rules[rulespace]() {
-						goto l96
+						goto l102
 					}
-					goto l95
-				l96:
-					position, tokenIndex = position96, tokenIndex96
+					goto l101
+				l102:
+					position, tokenIndex = position102, tokenIndex102
 				}
-				if !_rules[rulecolumn_name]() {
-					goto l93
+				if !_rules[ruleenum_title]() {
+					goto l99
 				}
+			l103:
 				{
-					position97, tokenIndex97 := position, tokenIndex
-				l99:
-					{
-						position100, tokenIndex100 := position, tokenIndex
-						if !_rules[rulespace]() {
-							goto l100
-						}
-						goto l99
-					l100:
-						position, tokenIndex = position100, tokenIndex100
-					}
-					if buffer[position] != rune('{') {
-						goto l97
+					position104, tokenIndex104 := position, tokenIndex
+					if !_rules[rulews]() {
+						goto l104
 					}
-					position++
-				l101:
-					{
-						position102, tokenIndex102 := position, tokenIndex
-						if !_rules[rulews]() {
-							goto l102
-						}
-						goto l101
-					l102:
-						position, tokenIndex = position102, tokenIndex102
+					goto l103
+				l104:
+					position, tokenIndex = position104, tokenIndex104
+				}
+				if buffer[position] != rune('{') {
+					goto l99
+				}
+				position++
+			l105:
+				{
+					position106, tokenIndex106 := position, tokenIndex
+					if !_rules[rulews]() {
+						goto l106
 					}
-				l103:
-					{
-						position104, tokenIndex104 := position, tokenIndex
-						if !_rules[rulecolumn_attribute]() {
-							goto l104
-						}
-					l105:
-						{
-							position106, tokenIndex106 := position, tokenIndex
-							if !_rules[rulews]() {
-								goto l106
-							}
-							goto l105
-						l106:
-							position, tokenIndex = position106, tokenIndex106
-						}
-						{
-							position107, tokenIndex107 := position, tokenIndex
-							if !_rules[ruleattribute_sep]() {
-								goto l107
-							}
-							goto l108
-						l107:
-							position, tokenIndex = position107, tokenIndex107
-						}
-					l108:
-						goto l103
-					l104:
-						position, tokenIndex = position104, tokenIndex104
+					goto l105
+				l106:
+					position, tokenIndex = position106, tokenIndex106
+				}
+			l107:
+				{
+					position108, tokenIndex108 := position, tokenIndex
+					if !_rules[ruleenum_value]() {
+						goto l108
 					}
 				l109:
 					{
@@ -1179,1318 +1366,4705 @@ func (p *Parser) Init(options ...func(*Parser) error) error {
 					l110:
 						position, tokenIndex = position110, tokenIndex110
 					}
-					if buffer[position] != rune('}') {
-						goto l97
+					{
+						position111, tokenIndex111 := position, tokenIndex
+						if !_rules[ruleattribute_sep]() {
+							goto l111
+						}
+						goto l112
+					l111:
+						position, tokenIndex = position111, tokenIndex111
 					}
-					position++
-				l111:
+				l112:
+				l113:
 					{
-						position112, tokenIndex112 := position, tokenIndex
-						if !_rules[rulespace]() {
-							goto l112
+						position114, tokenIndex114 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l114
 						}
-						goto l111
-					l112:
-						position, tokenIndex = position112, tokenIndex112
+						goto l113
+					l114:
+						position, tokenIndex = position114, tokenIndex114
+					}
+					goto l107
+				l108:
+					position, tokenIndex = position108, tokenIndex108
+				}
+			l115:
+				{
+					position116, tokenIndex116 := position, tokenIndex
+					if !_rules[rulews]() {
+						goto l116
 					}
-					goto l98
-				l97:
-					position, tokenIndex = position97, tokenIndex97
+					goto l115
+				l116:
+					position, tokenIndex = position116, tokenIndex116
+				}
+				if buffer[position] != rune('}') {
+					goto l99
 				}
-			l98:
+				position++
 				if !_rules[rulenewline_or_eot]() {
-					goto l93
+					goto l99
 				}
-				add(ruletable_column, position94)
+				add(ruleenum_info, position100)
 			}
 			return true
-		l93:
-			position, tokenIndex = position93, tokenIndex93
+		l99:
+			position, tokenIndex = position99, tokenIndex99
 			return false
 		},
-		/* 11 column_name <- <(<string> Action5)> */
+		/* 11 enum_title <- <(<string> Action6)> */
 		func() bool {
-			position113, tokenIndex113 := position, tokenIndex
+			position117, tokenIndex117 := position, tokenIndex
 			{
-				position114 := position
+				position118 := position
 				{
-					position115 := position
+					position119 := position
 					if !_rules[rulestring]() {
-						goto l113
+						goto l117
 					}
-					add(rulePegText, position115)
+					add(rulePegText, position119)
 				}
-				if !_rules[ruleAction5]() {
-					goto l113
+				if !_rules[ruleAction6]() {
+					goto l117
 				}
-				add(rulecolumn_name, position114)
+				add(ruleenum_title, position118)
 			}
 			return true
-		l113:
-			position, tokenIndex = position113, tokenIndex113
+		l117:
+			position, tokenIndex = position117, tokenIndex117
 			return false
 		},
-		/* 12 relation_info <- <(space* relation_left space* cardinality_left ('-' '-') cardinality_right space* relation_right (ws* '{' ws* (relation_attribute ws* attribute_sep? ws*)* ws* '}')? newline_or_eot Action6)> */
+		/* 12 enum_value <- <(<(('"' string_in_quote '"') / string)> Action7)> */
 		func() bool {
-			position116, tokenIndex116 := position, tokenIndex
+			position120, tokenIndex120 := position, tokenIndex
 			{
-				position117 := position
-			l118:
+				position121 := position
 				{
-					position119, tokenIndex119 := position, tokenIndex
+					position122 := position
+					{
+						position123, tokenIndex123 := position, tokenIndex
+						if buffer[position] != rune('"') {
+							goto l124
+						}
+						position++
+						if !_rules[rulestring_in_quote]() {
+							goto l124
+						}
+						if buffer[position] != rune('"') {
+							goto l124
+						}
+						position++
+						goto l123
+					l124:
+						position, tokenIndex = position123, tokenIndex123
+						if !_rules[rulestring]() {
+							goto l120
+						}
+					}
+				l123:
+					add(rulePegText, position122)
+				}
+				if !_rules[ruleAction7]() {
+					goto l120
+				}
+				add(ruleenum_value, position121)
+			}
+			return true
+		l120:
+			position, tokenIndex = position120, tokenIndex120
+			return false
+		},
+		/* 13 note_info <- <('n' 'o' 't' 'e' space+ note_text (space* '{' ws* (note_attribute ws* attribute_sep? ws*)* ws* '}')? space* newline_or_eot)> */
+		func() bool {
+			position125, tokenIndex125 := position, tokenIndex
+			{
+				position126 := position
+				if buffer[position] != rune('n') {
+					goto l125
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l125
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l125
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l125
+				}
+				position++
+				if !_rules[rulespace]() {
+					goto l125
+				}
+			l127:
+				{
+					position128, tokenIndex128 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l128
+					}
+					goto l127
+				l128:
+					position, tokenIndex = position128, tokenIndex128
+				}
+				if !_rules[rulenote_text]() {
+					goto l125
+				}
+				{
+					position129, tokenIndex129 := position, tokenIndex
+				l131:
+					{
+						position132, tokenIndex132 := position, tokenIndex
+						if !_rules[rulespace]() {
+							goto l132
+						}
+						goto l131
+					l132:
+						position, tokenIndex = position132, tokenIndex132
+					}
+					if buffer[position] != rune('{') {
+						goto l129
+					}
+					position++
+				l133:
+					{
+						position134, tokenIndex134 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l134
+						}
+						goto l133
+					l134:
+						position, tokenIndex = position134, tokenIndex134
+					}
+				l135:
+					{
+						position136, tokenIndex136 := position, tokenIndex
+						if !_rules[rulenote_attribute]() {
+							goto l136
+						}
+					l137:
+						{
+							position138, tokenIndex138 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l138
+							}
+							goto l137
+						l138:
+							position, tokenIndex = position138, tokenIndex138
+						}
+						{
+							position139, tokenIndex139 := position, tokenIndex
+							if !_rules[ruleattribute_sep]() {
+								goto l139
+							}
+							goto l140
+						l139:
+							position, tokenIndex = position139, tokenIndex139
+						}
+					l140:
+					l141:
+						{
+							position142, tokenIndex142 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l142
+							}
+							goto l141
+						l142:
+							position, tokenIndex = position142, tokenIndex142
+						}
+						goto l135
+					l136:
+						position, tokenIndex = position136, tokenIndex136
+					}
+				l143:
+					{
+						position144, tokenIndex144 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l144
+						}
+						goto l143
+					l144:
+						position, tokenIndex = position144, tokenIndex144
+					}
+					if buffer[position] != rune('}') {
+						goto l129
+					}
+					position++
+					goto l130
+				l129:
+					position, tokenIndex = position129, tokenIndex129
+				}
+			l130:
+			l145:
+				{
+					position146, tokenIndex146 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l146
+					}
+					goto l145
+				l146:
+					position, tokenIndex = position146, tokenIndex146
+				}
+				if !_rules[rulenewline_or_eot]() {
+					goto l125
+				}
+				add(rulenote_info, position126)
+			}
+			return true
+		l125:
+			position, tokenIndex = position125, tokenIndex125
+			return false
+		},
+		/* 14 note_text <- <(<('"' string_in_quote '"')> Action8)> */
+		func() bool {
+			position147, tokenIndex147 := position, tokenIndex
+			{
+				position148 := position
+				{
+					position149 := position
+					if buffer[position] != rune('"') {
+						goto l147
+					}
+					position++
+					if !_rules[rulestring_in_quote]() {
+						goto l147
+					}
+					if buffer[position] != rune('"') {
+						goto l147
+					}
+					position++
+					add(rulePegText, position149)
+				}
+				if !_rules[ruleAction8]() {
+					goto l147
+				}
+				add(rulenote_text, position148)
+			}
+			return true
+		l147:
+			position, tokenIndex = position147, tokenIndex147
+			return false
+		},
+		/* 15 title_info <- <('t' 'i' 't' 'l' 'e' ws* '{' ws* (title_attribute ws* attribute_sep? ws*)* ws* '}' newline)> */
+		func() bool {
+			position150, tokenIndex150 := position, tokenIndex
+			{
+				position151 := position
+				if buffer[position] != rune('t') {
+					goto l150
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l150
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l150
+				}
+				position++
+				if buffer[position] != rune('l') {
+					goto l150
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l150
+				}
+				position++
+			l152:
+				{
+					position153, tokenIndex153 := position, tokenIndex
+					if !_rules[rulews]() {
+						goto l153
+					}
+					goto l152
+				l153:
+					position, tokenIndex = position153, tokenIndex153
+				}
+				if buffer[position] != rune('{') {
+					goto l150
+				}
+				position++
+			l154:
+				{
+					position155, tokenIndex155 := position, tokenIndex
+					if !_rules[rulews]() {
+						goto l155
+					}
+					goto l154
+				l155:
+					position, tokenIndex = position155, tokenIndex155
+				}
+			l156:
+				{
+					position157, tokenIndex157 := position, tokenIndex
+					if !_rules[ruletitle_attribute]() {
+						goto l157
+					}
+				l158:
+					{
+						position159, tokenIndex159 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l159
+						}
+						goto l158
+					l159:
+						position, tokenIndex = position159, tokenIndex159
+					}
+					{
+						position160, tokenIndex160 := position, tokenIndex
+						if !_rules[ruleattribute_sep]() {
+							goto l160
+						}
+						goto l161
+					l160:
+						position, tokenIndex = position160, tokenIndex160
+					}
+				l161:
+				l162:
+					{
+						position163, tokenIndex163 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l163
+						}
+						goto l162
+					l163:
+						position, tokenIndex = position163, tokenIndex163
+					}
+					goto l156
+				l157:
+					position, tokenIndex = position157, tokenIndex157
+				}
+			l164:
+				{
+					position165, tokenIndex165 := position, tokenIndex
+					if !_rules[rulews]() {
+						goto l165
+					}
+					goto l164
+				l165:
+					position, tokenIndex = position165, tokenIndex165
+				}
+				if buffer[position] != rune('}') {
+					goto l150
+				}
+				position++
+				if !_rules[rulenewline]() {
+					goto l150
+				}
+				add(ruletitle_info, position151)
+			}
+			return true
+		l150:
+			position, tokenIndex = position150, tokenIndex150
+			return false
+		},
+		/* 16 graph_info <- <('g' 'r' 'a' 'p' 'h' ws* '{' ws* (graph_attribute ws* attribute_sep? ws*)* ws* '}' newline_or_eot)> */
+		func() bool {
+			position166, tokenIndex166 := position, tokenIndex
+			{
+				position167 := position
+				if buffer[position] != rune('g') {
+					goto l166
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l166
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l166
+				}
+				position++
+				if buffer[position] != rune('p') {
+					goto l166
+				}
+				position++
+				if buffer[position] != rune('h') {
+					goto l166
+				}
+				position++
+			l168:
+				{
+					position169, tokenIndex169 := position, tokenIndex
+					if !_rules[rulews]() {
+						goto l169
+					}
+					goto l168
+				l169:
+					position, tokenIndex = position169, tokenIndex169
+				}
+				if buffer[position] != rune('{') {
+					goto l166
+				}
+				position++
+			l170:
+				{
+					position171, tokenIndex171 := position, tokenIndex
+					if !_rules[rulews]() {
+						goto l171
+					}
+					goto l170
+				l171:
+					position, tokenIndex = position171, tokenIndex171
+				}
+			l172:
+				{
+					position173, tokenIndex173 := position, tokenIndex
+					if !_rules[rulegraph_attribute]() {
+						goto l173
+					}
+				l174:
+					{
+						position175, tokenIndex175 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l175
+						}
+						goto l174
+					l175:
+						position, tokenIndex = position175, tokenIndex175
+					}
+					{
+						position176, tokenIndex176 := position, tokenIndex
+						if !_rules[ruleattribute_sep]() {
+							goto l176
+						}
+						goto l177
+					l176:
+						position, tokenIndex = position176, tokenIndex176
+					}
+				l177:
+				l178:
+					{
+						position179, tokenIndex179 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l179
+						}
+						goto l178
+					l179:
+						position, tokenIndex = position179, tokenIndex179
+					}
+					goto l172
+				l173:
+					position, tokenIndex = position173, tokenIndex173
+				}
+			l180:
+				{
+					position181, tokenIndex181 := position, tokenIndex
+					if !_rules[rulews]() {
+						goto l181
+					}
+					goto l180
+				l181:
+					position, tokenIndex = position181, tokenIndex181
+				}
+				if buffer[position] != rune('}') {
+					goto l166
+				}
+				position++
+				if !_rules[rulenewline_or_eot]() {
+					goto l166
+				}
+				add(rulegraph_info, position167)
+			}
+			return true
+		l166:
+			position, tokenIndex = position166, tokenIndex166
+			return false
+		},
+		/* 17 table_info <- <('[' table_title ']' (space+ ('e' 'x' 't' 'e' 'n' 'd' 's') space+ table_parent)? (space* '{' ws* (table_attribute ws* attribute_sep?)* ws* '}' space*)? newline_or_eot (table_column / empty_line)*)> */
+		func() bool {
+			position182, tokenIndex182 := position, tokenIndex
+			{
+				position183 := position
+				if buffer[position] != rune('[') {
+					goto l182
+				}
+				position++
+				if !_rules[ruletable_title]() {
+					goto l182
+				}
+				if buffer[position] != rune(']') {
+					goto l182
+				}
+				position++
+				{
+					position184, tokenIndex184 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l184
+					}
+				l186:
+					{
+						position187, tokenIndex187 := position, tokenIndex
+						if !_rules[rulespace]() {
+							goto l187
+						}
+						goto l186
+					l187:
+						position, tokenIndex = position187, tokenIndex187
+					}
+					if buffer[position] != rune('e') {
+						goto l184
+					}
+					position++
+					if buffer[position] != rune('x') {
+						goto l184
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l184
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l184
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l184
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l184
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l184
+					}
+					position++
+					if !_rules[rulespace]() {
+						goto l184
+					}
+				l188:
+					{
+						position189, tokenIndex189 := position, tokenIndex
+						if !_rules[rulespace]() {
+							goto l189
+						}
+						goto l188
+					l189:
+						position, tokenIndex = position189, tokenIndex189
+					}
+					if !_rules[ruletable_parent]() {
+						goto l184
+					}
+					goto l185
+				l184:
+					position, tokenIndex = position184, tokenIndex184
+				}
+			l185:
+				{
+					position190, tokenIndex190 := position, tokenIndex
+				l192:
+					{
+						position193, tokenIndex193 := position, tokenIndex
+						if !_rules[rulespace]() {
+							goto l193
+						}
+						goto l192
+					l193:
+						position, tokenIndex = position193, tokenIndex193
+					}
+					if buffer[position] != rune('{') {
+						goto l190
+					}
+					position++
+				l194:
+					{
+						position195, tokenIndex195 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l195
+						}
+						goto l194
+					l195:
+						position, tokenIndex = position195, tokenIndex195
+					}
+				l196:
+					{
+						position197, tokenIndex197 := position, tokenIndex
+						if !_rules[ruletable_attribute]() {
+							goto l197
+						}
+					l198:
+						{
+							position199, tokenIndex199 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l199
+							}
+							goto l198
+						l199:
+							position, tokenIndex = position199, tokenIndex199
+						}
+						{
+							position200, tokenIndex200 := position, tokenIndex
+							if !_rules[ruleattribute_sep]() {
+								goto l200
+							}
+							goto l201
+						l200:
+							position, tokenIndex = position200, tokenIndex200
+						}
+					l201:
+						goto l196
+					l197:
+						position, tokenIndex = position197, tokenIndex197
+					}
+				l202:
+					{
+						position203, tokenIndex203 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l203
+						}
+						goto l202
+					l203:
+						position, tokenIndex = position203, tokenIndex203
+					}
+					if buffer[position] != rune('}') {
+						goto l190
+					}
+					position++
+				l204:
+					{
+						position205, tokenIndex205 := position, tokenIndex
+						if !_rules[rulespace]() {
+							goto l205
+						}
+						goto l204
+					l205:
+						position, tokenIndex = position205, tokenIndex205
+					}
+					goto l191
+				l190:
+					position, tokenIndex = position190, tokenIndex190
+				}
+			l191:
+				if !_rules[rulenewline_or_eot]() {
+					goto l182
+				}
+			l206:
+				{
+					position207, tokenIndex207 := position, tokenIndex
+					{
+						position208, tokenIndex208 := position, tokenIndex
+						if !_rules[ruletable_column]() {
+							goto l209
+						}
+						goto l208
+					l209:
+						position, tokenIndex = position208, tokenIndex208
+						if !_rules[ruleempty_line]() {
+							goto l207
+						}
+					}
+				l208:
+					goto l206
+				l207:
+					position, tokenIndex = position207, tokenIndex207
+				}
+				add(ruletable_info, position183)
+			}
+			return true
+		l182:
+			position, tokenIndex = position182, tokenIndex182
+			return false
+		},
+		/* 18 table_title <- <(<string> Action9)> */
+		func() bool {
+			position210, tokenIndex210 := position, tokenIndex
+			{
+				position211 := position
+				{
+					position212 := position
+					if !_rules[rulestring]() {
+						goto l210
+					}
+					add(rulePegText, position212)
+				}
+				if !_rules[ruleAction9]() {
+					goto l210
+				}
+				add(ruletable_title, position211)
+			}
+			return true
+		l210:
+			position, tokenIndex = position210, tokenIndex210
+			return false
+		},
+		/* 19 table_parent <- <(<string> Action10)> */
+		func() bool {
+			position213, tokenIndex213 := position, tokenIndex
+			{
+				position214 := position
+				{
+					position215 := position
+					if !_rules[rulestring]() {
+						goto l213
+					}
+					add(rulePegText, position215)
+				}
+				if !_rules[ruleAction10]() {
+					goto l213
+				}
+				add(ruletable_parent, position214)
+			}
+			return true
+		l213:
+			position, tokenIndex = position213, tokenIndex213
+			return false
+		},
+		/* 20 table_column <- <(space* !'#' column_name (space+ column_definition)? (space* '{' ws* (column_attribute ws* attribute_sep?)* ws* '}' space*)? newline_or_eot)> */
+		func() bool {
+			position216, tokenIndex216 := position, tokenIndex
+			{
+				position217 := position
+			l218:
+				{
+					position219, tokenIndex219 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l219
+					}
+					goto l218
+				l219:
+					position, tokenIndex = position219, tokenIndex219
+				}
+				{
+					position220, tokenIndex220 := position, tokenIndex
+					if buffer[position] != rune('#') {
+						goto l220
+					}
+					position++
+					goto l216
+				l220:
+					position, tokenIndex = position220, tokenIndex220
+				}
+				if !_rules[rulecolumn_name]() {
+					goto l216
+				}
+				{
+					position221, tokenIndex221 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l221
+					}
+				l223:
+					{
+						position224, tokenIndex224 := position, tokenIndex
+						if !_rules[rulespace]() {
+							goto l224
+						}
+						goto l223
+					l224:
+						position, tokenIndex = position224, tokenIndex224
+					}
+					if !_rules[rulecolumn_definition]() {
+						goto l221
+					}
+					goto l222
+				l221:
+					position, tokenIndex = position221, tokenIndex221
+				}
+			l222:
+				{
+					position225, tokenIndex225 := position, tokenIndex
+				l227:
+					{
+						position228, tokenIndex228 := position, tokenIndex
+						if !_rules[rulespace]() {
+							goto l228
+						}
+						goto l227
+					l228:
+						position, tokenIndex = position228, tokenIndex228
+					}
+					if buffer[position] != rune('{') {
+						goto l225
+					}
+					position++
+				l229:
+					{
+						position230, tokenIndex230 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l230
+						}
+						goto l229
+					l230:
+						position, tokenIndex = position230, tokenIndex230
+					}
+				l231:
+					{
+						position232, tokenIndex232 := position, tokenIndex
+						if !_rules[rulecolumn_attribute]() {
+							goto l232
+						}
+					l233:
+						{
+							position234, tokenIndex234 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l234
+							}
+							goto l233
+						l234:
+							position, tokenIndex = position234, tokenIndex234
+						}
+						{
+							position235, tokenIndex235 := position, tokenIndex
+							if !_rules[ruleattribute_sep]() {
+								goto l235
+							}
+							goto l236
+						l235:
+							position, tokenIndex = position235, tokenIndex235
+						}
+					l236:
+						goto l231
+					l232:
+						position, tokenIndex = position232, tokenIndex232
+					}
+				l237:
+					{
+						position238, tokenIndex238 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l238
+						}
+						goto l237
+					l238:
+						position, tokenIndex = position238, tokenIndex238
+					}
+					if buffer[position] != rune('}') {
+						goto l225
+					}
+					position++
+				l239:
+					{
+						position240, tokenIndex240 := position, tokenIndex
+						if !_rules[rulespace]() {
+							goto l240
+						}
+						goto l239
+					l240:
+						position, tokenIndex = position240, tokenIndex240
+					}
+					goto l226
+				l225:
+					position, tokenIndex = position225, tokenIndex225
+				}
+			l226:
+				if !_rules[rulenewline_or_eot]() {
+					goto l216
+				}
+				add(ruletable_column, position217)
+			}
+			return true
+		l216:
+			position, tokenIndex = position216, tokenIndex216
+			return false
+		},
+		/* 21 column_name <- <(<string> Action11)> */
+		func() bool {
+			position241, tokenIndex241 := position, tokenIndex
+			{
+				position242 := position
+				{
+					position243 := position
+					if !_rules[rulestring]() {
+						goto l241
+					}
+					add(rulePegText, position243)
+				}
+				if !_rules[ruleAction11]() {
+					goto l241
+				}
+				add(rulecolumn_name, position242)
+			}
+			return true
+		l241:
+			position, tokenIndex = position241, tokenIndex241
+			return false
+		},
+		/* 22 column_definition <- <((column_type / column_constraint) (space+ column_constraint)*)> */
+		func() bool {
+			position244, tokenIndex244 := position, tokenIndex
+			{
+				position245 := position
+				{
+					position246, tokenIndex246 := position, tokenIndex
+					if !_rules[rulecolumn_type]() {
+						goto l247
+					}
+					goto l246
+				l247:
+					position, tokenIndex = position246, tokenIndex246
+					if !_rules[rulecolumn_constraint]() {
+						goto l244
+					}
+				}
+			l246:
+			l248:
+				{
+					position249, tokenIndex249 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l249
+					}
+				l250:
+					{
+						position251, tokenIndex251 := position, tokenIndex
+						if !_rules[rulespace]() {
+							goto l251
+						}
+						goto l250
+					l251:
+						position, tokenIndex = position251, tokenIndex251
+					}
+					if !_rules[rulecolumn_constraint]() {
+						goto l249
+					}
+					goto l248
+				l249:
+					position, tokenIndex = position249, tokenIndex249
+				}
+				add(rulecolumn_definition, position245)
+			}
+			return true
+		l244:
+			position, tokenIndex = position244, tokenIndex244
+			return false
+		},
+		/* 23 column_type <- <(<(type_word (space+ type_word)*)> Action12)> */
+		func() bool {
+			position252, tokenIndex252 := position, tokenIndex
+			{
+				position253 := position
+				{
+					position254 := position
+					if !_rules[ruletype_word]() {
+						goto l252
+					}
+				l255:
+					{
+						position256, tokenIndex256 := position, tokenIndex
+						if !_rules[rulespace]() {
+							goto l256
+						}
+					l257:
+						{
+							position258, tokenIndex258 := position, tokenIndex
+							if !_rules[rulespace]() {
+								goto l258
+							}
+							goto l257
+						l258:
+							position, tokenIndex = position258, tokenIndex258
+						}
+						if !_rules[ruletype_word]() {
+							goto l256
+						}
+						goto l255
+					l256:
+						position, tokenIndex = position256, tokenIndex256
+					}
+					add(rulePegText, position254)
+				}
+				if !_rules[ruleAction12]() {
+					goto l252
+				}
+				add(rulecolumn_type, position253)
+			}
+			return true
+		l252:
+			position, tokenIndex = position252, tokenIndex252
+			return false
+		},
+		/* 24 type_word <- <(!constraint_keyword ([a-z] / [A-Z] / '_') ([a-z] / [A-Z] / [0-9] / '_' / '.')* parenthesized? ('[' [0-9]* ']')*)> */
+		func() bool {
+			position259, tokenIndex259 := position, tokenIndex
+			{
+				position260 := position
+				{
+					position261, tokenIndex261 := position, tokenIndex
+					if !_rules[ruleconstraint_keyword]() {
+						goto l261
+					}
+					goto l259
+				l261:
+					position, tokenIndex = position261, tokenIndex261
+				}
+				{
+					position262, tokenIndex262 := position, tokenIndex
+					if c := buffer[position]; c < rune('a') || c > rune('z') {
+						goto l263
+					}
+					position++
+					goto l262
+				l263:
+					position, tokenIndex = position262, tokenIndex262
+					if c := buffer[position]; c < rune('A') || c > rune('Z') {
+						goto l264
+					}
+					position++
+					goto l262
+				l264:
+					position, tokenIndex = position262, tokenIndex262
+					if buffer[position] != rune('_') {
+						goto l259
+					}
+					position++
+				}
+			l262:
+			l265:
+				{
+					position266, tokenIndex266 := position, tokenIndex
+					{
+						position267, tokenIndex267 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l268
+						}
+						position++
+						goto l267
+					l268:
+						position, tokenIndex = position267, tokenIndex267
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l269
+						}
+						position++
+						goto l267
+					l269:
+						position, tokenIndex = position267, tokenIndex267
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l270
+						}
+						position++
+						goto l267
+					l270:
+						position, tokenIndex = position267, tokenIndex267
+						if buffer[position] != rune('_') {
+							goto l271
+						}
+						position++
+						goto l267
+					l271:
+						position, tokenIndex = position267, tokenIndex267
+						if buffer[position] != rune('.') {
+							goto l266
+						}
+						position++
+					}
+				l267:
+					goto l265
+				l266:
+					position, tokenIndex = position266, tokenIndex266
+				}
+				{
+					position272, tokenIndex272 := position, tokenIndex
+					if !_rules[ruleparenthesized]() {
+						goto l272
+					}
+					goto l273
+				l272:
+					position, tokenIndex = position272, tokenIndex272
+				}
+			l273:
+			l274:
+				{
+					position275, tokenIndex275 := position, tokenIndex
+					if buffer[position] != rune('[') {
+						goto l275
+					}
+					position++
+				l276:
+					{
+						position277, tokenIndex277 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l277
+						}
+						position++
+						goto l276
+					l277:
+						position, tokenIndex = position277, tokenIndex277
+					}
+					if buffer[position] != rune(']') {
+						goto l275
+					}
+					position++
+					goto l274
+				l275:
+					position, tokenIndex = position275, tokenIndex275
+				}
+				add(ruletype_word, position260)
+			}
+			return true
+		l259:
+			position, tokenIndex = position259, tokenIndex259
+			return false
+		},
+		/* 25 constraint_keyword <- <(((('n' / 'N') ('o' / 'O') ('t' / 'T')) / (('n' / 'N') ('u' / 'U') ('l' / 'L') ('l' / 'L')) / (('d' / 'D') ('e' / 'E') ('f' / 'F') ('a' / 'A') ('u' / 'U') ('l' / 'L') ('t' / 'T')) / (('u' / 'U') ('n' / 'N') ('i' / 'I') ('q' / 'Q') ('u' / 'U') ('e' / 'E')) / (('c' / 'C') ('h' / 'H') ('e' / 'E') ('c' / 'C') ('k' / 'K'))) !([a-z] / [A-Z] / [0-9] / '_'))> */
+		func() bool {
+			position278, tokenIndex278 := position, tokenIndex
+			{
+				position279 := position
+				{
+					position280, tokenIndex280 := position, tokenIndex
+					{
+						position282, tokenIndex282 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l283
+						}
+						position++
+						goto l282
+					l283:
+						position, tokenIndex = position282, tokenIndex282
+						if buffer[position] != rune('N') {
+							goto l281
+						}
+						position++
+					}
+				l282:
+					{
+						position284, tokenIndex284 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l285
+						}
+						position++
+						goto l284
+					l285:
+						position, tokenIndex = position284, tokenIndex284
+						if buffer[position] != rune('O') {
+							goto l281
+						}
+						position++
+					}
+				l284:
+					{
+						position286, tokenIndex286 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l287
+						}
+						position++
+						goto l286
+					l287:
+						position, tokenIndex = position286, tokenIndex286
+						if buffer[position] != rune('T') {
+							goto l281
+						}
+						position++
+					}
+				l286:
+					goto l280
+				l281:
+					position, tokenIndex = position280, tokenIndex280
+					{
+						position289, tokenIndex289 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l290
+						}
+						position++
+						goto l289
+					l290:
+						position, tokenIndex = position289, tokenIndex289
+						if buffer[position] != rune('N') {
+							goto l288
+						}
+						position++
+					}
+				l289:
+					{
+						position291, tokenIndex291 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l292
+						}
+						position++
+						goto l291
+					l292:
+						position, tokenIndex = position291, tokenIndex291
+						if buffer[position] != rune('U') {
+							goto l288
+						}
+						position++
+					}
+				l291:
+					{
+						position293, tokenIndex293 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l294
+						}
+						position++
+						goto l293
+					l294:
+						position, tokenIndex = position293, tokenIndex293
+						if buffer[position] != rune('L') {
+							goto l288
+						}
+						position++
+					}
+				l293:
+					{
+						position295, tokenIndex295 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l296
+						}
+						position++
+						goto l295
+					l296:
+						position, tokenIndex = position295, tokenIndex295
+						if buffer[position] != rune('L') {
+							goto l288
+						}
+						position++
+					}
+				l295:
+					goto l280
+				l288:
+					position, tokenIndex = position280, tokenIndex280
+					{
+						position298, tokenIndex298 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l299
+						}
+						position++
+						goto l298
+					l299:
+						position, tokenIndex = position298, tokenIndex298
+						if buffer[position] != rune('D') {
+							goto l297
+						}
+						position++
+					}
+				l298:
+					{
+						position300, tokenIndex300 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l301
+						}
+						position++
+						goto l300
+					l301:
+						position, tokenIndex = position300, tokenIndex300
+						if buffer[position] != rune('E') {
+							goto l297
+						}
+						position++
+					}
+				l300:
+					{
+						position302, tokenIndex302 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l303
+						}
+						position++
+						goto l302
+					l303:
+						position, tokenIndex = position302, tokenIndex302
+						if buffer[position] != rune('F') {
+							goto l297
+						}
+						position++
+					}
+				l302:
+					{
+						position304, tokenIndex304 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l305
+						}
+						position++
+						goto l304
+					l305:
+						position, tokenIndex = position304, tokenIndex304
+						if buffer[position] != rune('A') {
+							goto l297
+						}
+						position++
+					}
+				l304:
+					{
+						position306, tokenIndex306 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l307
+						}
+						position++
+						goto l306
+					l307:
+						position, tokenIndex = position306, tokenIndex306
+						if buffer[position] != rune('U') {
+							goto l297
+						}
+						position++
+					}
+				l306:
+					{
+						position308, tokenIndex308 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l309
+						}
+						position++
+						goto l308
+					l309:
+						position, tokenIndex = position308, tokenIndex308
+						if buffer[position] != rune('L') {
+							goto l297
+						}
+						position++
+					}
+				l308:
+					{
+						position310, tokenIndex310 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l311
+						}
+						position++
+						goto l310
+					l311:
+						position, tokenIndex = position310, tokenIndex310
+						if buffer[position] != rune('T') {
+							goto l297
+						}
+						position++
+					}
+				l310:
+					goto l280
+				l297:
+					position, tokenIndex = position280, tokenIndex280
+					{
+						position313, tokenIndex313 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l314
+						}
+						position++
+						goto l313
+					l314:
+						position, tokenIndex = position313, tokenIndex313
+						if buffer[position] != rune('U') {
+							goto l312
+						}
+						position++
+					}
+				l313:
+					{
+						position315, tokenIndex315 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l316
+						}
+						position++
+						goto l315
+					l316:
+						position, tokenIndex = position315, tokenIndex315
+						if buffer[position] != rune('N') {
+							goto l312
+						}
+						position++
+					}
+				l315:
+					{
+						position317, tokenIndex317 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l318
+						}
+						position++
+						goto l317
+					l318:
+						position, tokenIndex = position317, tokenIndex317
+						if buffer[position] != rune('I') {
+							goto l312
+						}
+						position++
+					}
+				l317:
+					{
+						position319, tokenIndex319 := position, tokenIndex
+						if buffer[position] != rune('q') {
+							goto l320
+						}
+						position++
+						goto l319
+					l320:
+						position, tokenIndex = position319, tokenIndex319
+						if buffer[position] != rune('Q') {
+							goto l312
+						}
+						position++
+					}
+				l319:
+					{
+						position321, tokenIndex321 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l322
+						}
+						position++
+						goto l321
+					l322:
+						position, tokenIndex = position321, tokenIndex321
+						if buffer[position] != rune('U') {
+							goto l312
+						}
+						position++
+					}
+				l321:
+					{
+						position323, tokenIndex323 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l324
+						}
+						position++
+						goto l323
+					l324:
+						position, tokenIndex = position323, tokenIndex323
+						if buffer[position] != rune('E') {
+							goto l312
+						}
+						position++
+					}
+				l323:
+					goto l280
+				l312:
+					position, tokenIndex = position280, tokenIndex280
+					{
+						position325, tokenIndex325 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l326
+						}
+						position++
+						goto l325
+					l326:
+						position, tokenIndex = position325, tokenIndex325
+						if buffer[position] != rune('C') {
+							goto l278
+						}
+						position++
+					}
+				l325:
+					{
+						position327, tokenIndex327 := position, tokenIndex
+						if buffer[position] != rune('h') {
+							goto l328
+						}
+						position++
+						goto l327
+					l328:
+						position, tokenIndex = position327, tokenIndex327
+						if buffer[position] != rune('H') {
+							goto l278
+						}
+						position++
+					}
+				l327:
+					{
+						position329, tokenIndex329 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l330
+						}
+						position++
+						goto l329
+					l330:
+						position, tokenIndex = position329, tokenIndex329
+						if buffer[position] != rune('E') {
+							goto l278
+						}
+						position++
+					}
+				l329:
+					{
+						position331, tokenIndex331 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l332
+						}
+						position++
+						goto l331
+					l332:
+						position, tokenIndex = position331, tokenIndex331
+						if buffer[position] != rune('C') {
+							goto l278
+						}
+						position++
+					}
+				l331:
+					{
+						position333, tokenIndex333 := position, tokenIndex
+						if buffer[position] != rune('k') {
+							goto l334
+						}
+						position++
+						goto l333
+					l334:
+						position, tokenIndex = position333, tokenIndex333
+						if buffer[position] != rune('K') {
+							goto l278
+						}
+						position++
+					}
+				l333:
+				}
+			l280:
+				{
+					position335, tokenIndex335 := position, tokenIndex
+					{
+						position336, tokenIndex336 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l337
+						}
+						position++
+						goto l336
+					l337:
+						position, tokenIndex = position336, tokenIndex336
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l338
+						}
+						position++
+						goto l336
+					l338:
+						position, tokenIndex = position336, tokenIndex336
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l339
+						}
+						position++
+						goto l336
+					l339:
+						position, tokenIndex = position336, tokenIndex336
+						if buffer[position] != rune('_') {
+							goto l335
+						}
+						position++
+					}
+				l336:
+					goto l278
+				l335:
+					position, tokenIndex = position335, tokenIndex335
+				}
+				add(ruleconstraint_keyword, position279)
+			}
+			return true
+		l278:
+			position, tokenIndex = position278, tokenIndex278
+			return false
+		},
+		/* 26 column_constraint <- <(column_not_null / column_null / column_unique / column_default / column_check)> */
+		func() bool {
+			position340, tokenIndex340 := position, tokenIndex
+			{
+				position341 := position
+				{
+					position342, tokenIndex342 := position, tokenIndex
+					if !_rules[rulecolumn_not_null]() {
+						goto l343
+					}
+					goto l342
+				l343:
+					position, tokenIndex = position342, tokenIndex342
+					if !_rules[rulecolumn_null]() {
+						goto l344
+					}
+					goto l342
+				l344:
+					position, tokenIndex = position342, tokenIndex342
+					if !_rules[rulecolumn_unique]() {
+						goto l345
+					}
+					goto l342
+				l345:
+					position, tokenIndex = position342, tokenIndex342
+					if !_rules[rulecolumn_default]() {
+						goto l346
+					}
+					goto l342
+				l346:
+					position, tokenIndex = position342, tokenIndex342
+					if !_rules[rulecolumn_check]() {
+						goto l340
+					}
+				}
+			l342:
+				add(rulecolumn_constraint, position341)
+			}
+			return true
+		l340:
+			position, tokenIndex = position340, tokenIndex340
+			return false
+		},
+		/* 27 column_not_null <- <(('n' / 'N') ('o' / 'O') ('t' / 'T') space+ (('n' / 'N') ('u' / 'U') ('l' / 'L') ('l' / 'L')) !([a-z] / [A-Z] / [0-9] / '_') Action13)> */
+		func() bool {
+			position347, tokenIndex347 := position, tokenIndex
+			{
+				position348 := position
+				{
+					position349, tokenIndex349 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l350
+					}
+					position++
+					goto l349
+				l350:
+					position, tokenIndex = position349, tokenIndex349
+					if buffer[position] != rune('N') {
+						goto l347
+					}
+					position++
+				}
+			l349:
+				{
+					position351, tokenIndex351 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l352
+					}
+					position++
+					goto l351
+				l352:
+					position, tokenIndex = position351, tokenIndex351
+					if buffer[position] != rune('O') {
+						goto l347
+					}
+					position++
+				}
+			l351:
+				{
+					position353, tokenIndex353 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l354
+					}
+					position++
+					goto l353
+				l354:
+					position, tokenIndex = position353, tokenIndex353
+					if buffer[position] != rune('T') {
+						goto l347
+					}
+					position++
+				}
+			l353:
+				if !_rules[rulespace]() {
+					goto l347
+				}
+			l355:
+				{
+					position356, tokenIndex356 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l356
+					}
+					goto l355
+				l356:
+					position, tokenIndex = position356, tokenIndex356
+				}
+				{
+					position357, tokenIndex357 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l358
+					}
+					position++
+					goto l357
+				l358:
+					position, tokenIndex = position357, tokenIndex357
+					if buffer[position] != rune('N') {
+						goto l347
+					}
+					position++
+				}
+			l357:
+				{
+					position359, tokenIndex359 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l360
+					}
+					position++
+					goto l359
+				l360:
+					position, tokenIndex = position359, tokenIndex359
+					if buffer[position] != rune('U') {
+						goto l347
+					}
+					position++
+				}
+			l359:
+				{
+					position361, tokenIndex361 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l362
+					}
+					position++
+					goto l361
+				l362:
+					position, tokenIndex = position361, tokenIndex361
+					if buffer[position] != rune('L') {
+						goto l347
+					}
+					position++
+				}
+			l361:
+				{
+					position363, tokenIndex363 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l364
+					}
+					position++
+					goto l363
+				l364:
+					position, tokenIndex = position363, tokenIndex363
+					if buffer[position] != rune('L') {
+						goto l347
+					}
+					position++
+				}
+			l363:
+				{
+					position365, tokenIndex365 := position, tokenIndex
+					{
+						position366, tokenIndex366 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l367
+						}
+						position++
+						goto l366
+					l367:
+						position, tokenIndex = position366, tokenIndex366
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l368
+						}
+						position++
+						goto l366
+					l368:
+						position, tokenIndex = position366, tokenIndex366
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l369
+						}
+						position++
+						goto l366
+					l369:
+						position, tokenIndex = position366, tokenIndex366
+						if buffer[position] != rune('_') {
+							goto l365
+						}
+						position++
+					}
+				l366:
+					goto l347
+				l365:
+					position, tokenIndex = position365, tokenIndex365
+				}
+				if !_rules[ruleAction13]() {
+					goto l347
+				}
+				add(rulecolumn_not_null, position348)
+			}
+			return true
+		l347:
+			position, tokenIndex = position347, tokenIndex347
+			return false
+		},
+		/* 28 column_null <- <(('n' / 'N') ('u' / 'U') ('l' / 'L') ('l' / 'L') !([a-z] / [A-Z] / [0-9] / '_') Action14)> */
+		func() bool {
+			position370, tokenIndex370 := position, tokenIndex
+			{
+				position371 := position
+				{
+					position372, tokenIndex372 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l373
+					}
+					position++
+					goto l372
+				l373:
+					position, tokenIndex = position372, tokenIndex372
+					if buffer[position] != rune('N') {
+						goto l370
+					}
+					position++
+				}
+			l372:
+				{
+					position374, tokenIndex374 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l375
+					}
+					position++
+					goto l374
+				l375:
+					position, tokenIndex = position374, tokenIndex374
+					if buffer[position] != rune('U') {
+						goto l370
+					}
+					position++
+				}
+			l374:
+				{
+					position376, tokenIndex376 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l377
+					}
+					position++
+					goto l376
+				l377:
+					position, tokenIndex = position376, tokenIndex376
+					if buffer[position] != rune('L') {
+						goto l370
+					}
+					position++
+				}
+			l376:
+				{
+					position378, tokenIndex378 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l379
+					}
+					position++
+					goto l378
+				l379:
+					position, tokenIndex = position378, tokenIndex378
+					if buffer[position] != rune('L') {
+						goto l370
+					}
+					position++
+				}
+			l378:
+				{
+					position380, tokenIndex380 := position, tokenIndex
+					{
+						position381, tokenIndex381 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l382
+						}
+						position++
+						goto l381
+					l382:
+						position, tokenIndex = position381, tokenIndex381
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l383
+						}
+						position++
+						goto l381
+					l383:
+						position, tokenIndex = position381, tokenIndex381
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l384
+						}
+						position++
+						goto l381
+					l384:
+						position, tokenIndex = position381, tokenIndex381
+						if buffer[position] != rune('_') {
+							goto l380
+						}
+						position++
+					}
+				l381:
+					goto l370
+				l380:
+					position, tokenIndex = position380, tokenIndex380
+				}
+				if !_rules[ruleAction14]() {
+					goto l370
+				}
+				add(rulecolumn_null, position371)
+			}
+			return true
+		l370:
+			position, tokenIndex = position370, tokenIndex370
+			return false
+		},
+		/* 29 column_unique <- <(('u' / 'U') ('n' / 'N') ('i' / 'I') ('q' / 'Q') ('u' / 'U') ('e' / 'E') !([a-z] / [A-Z] / [0-9] / '_') Action15)> */
+		func() bool {
+			position385, tokenIndex385 := position, tokenIndex
+			{
+				position386 := position
+				{
+					position387, tokenIndex387 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l388
+					}
+					position++
+					goto l387
+				l388:
+					position, tokenIndex = position387, tokenIndex387
+					if buffer[position] != rune('U') {
+						goto l385
+					}
+					position++
+				}
+			l387:
+				{
+					position389, tokenIndex389 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l390
+					}
+					position++
+					goto l389
+				l390:
+					position, tokenIndex = position389, tokenIndex389
+					if buffer[position] != rune('N') {
+						goto l385
+					}
+					position++
+				}
+			l389:
+				{
+					position391, tokenIndex391 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l392
+					}
+					position++
+					goto l391
+				l392:
+					position, tokenIndex = position391, tokenIndex391
+					if buffer[position] != rune('I') {
+						goto l385
+					}
+					position++
+				}
+			l391:
+				{
+					position393, tokenIndex393 := position, tokenIndex
+					if buffer[position] != rune('q') {
+						goto l394
+					}
+					position++
+					goto l393
+				l394:
+					position, tokenIndex = position393, tokenIndex393
+					if buffer[position] != rune('Q') {
+						goto l385
+					}
+					position++
+				}
+			l393:
+				{
+					position395, tokenIndex395 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l396
+					}
+					position++
+					goto l395
+				l396:
+					position, tokenIndex = position395, tokenIndex395
+					if buffer[position] != rune('U') {
+						goto l385
+					}
+					position++
+				}
+			l395:
+				{
+					position397, tokenIndex397 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l398
+					}
+					position++
+					goto l397
+				l398:
+					position, tokenIndex = position397, tokenIndex397
+					if buffer[position] != rune('E') {
+						goto l385
+					}
+					position++
+				}
+			l397:
+				{
+					position399, tokenIndex399 := position, tokenIndex
+					{
+						position400, tokenIndex400 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l401
+						}
+						position++
+						goto l400
+					l401:
+						position, tokenIndex = position400, tokenIndex400
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l402
+						}
+						position++
+						goto l400
+					l402:
+						position, tokenIndex = position400, tokenIndex400
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l403
+						}
+						position++
+						goto l400
+					l403:
+						position, tokenIndex = position400, tokenIndex400
+						if buffer[position] != rune('_') {
+							goto l399
+						}
+						position++
+					}
+				l400:
+					goto l385
+				l399:
+					position, tokenIndex = position399, tokenIndex399
+				}
+				if !_rules[ruleAction15]() {
+					goto l385
+				}
+				add(rulecolumn_unique, position386)
+			}
+			return true
+		l385:
+			position, tokenIndex = position385, tokenIndex385
+			return false
+		},
+		/* 30 column_default <- <(('d' / 'D') ('e' / 'E') ('f' / 'F') ('a' / 'A') ('u' / 'U') ('l' / 'L') ('t' / 'T') space+ default_value)> */
+		func() bool {
+			position404, tokenIndex404 := position, tokenIndex
+			{
+				position405 := position
+				{
+					position406, tokenIndex406 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l407
+					}
+					position++
+					goto l406
+				l407:
+					position, tokenIndex = position406, tokenIndex406
+					if buffer[position] != rune('D') {
+						goto l404
+					}
+					position++
+				}
+			l406:
+				{
+					position408, tokenIndex408 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l409
+					}
+					position++
+					goto l408
+				l409:
+					position, tokenIndex = position408, tokenIndex408
+					if buffer[position] != rune('E') {
+						goto l404
+					}
+					position++
+				}
+			l408:
+				{
+					position410, tokenIndex410 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l411
+					}
+					position++
+					goto l410
+				l411:
+					position, tokenIndex = position410, tokenIndex410
+					if buffer[position] != rune('F') {
+						goto l404
+					}
+					position++
+				}
+			l410:
+				{
+					position412, tokenIndex412 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l413
+					}
+					position++
+					goto l412
+				l413:
+					position, tokenIndex = position412, tokenIndex412
+					if buffer[position] != rune('A') {
+						goto l404
+					}
+					position++
+				}
+			l412:
+				{
+					position414, tokenIndex414 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l415
+					}
+					position++
+					goto l414
+				l415:
+					position, tokenIndex = position414, tokenIndex414
+					if buffer[position] != rune('U') {
+						goto l404
+					}
+					position++
+				}
+			l414:
+				{
+					position416, tokenIndex416 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l417
+					}
+					position++
+					goto l416
+				l417:
+					position, tokenIndex = position416, tokenIndex416
+					if buffer[position] != rune('L') {
+						goto l404
+					}
+					position++
+				}
+			l416:
+				{
+					position418, tokenIndex418 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l419
+					}
+					position++
+					goto l418
+				l419:
+					position, tokenIndex = position418, tokenIndex418
+					if buffer[position] != rune('T') {
+						goto l404
+					}
+					position++
+				}
+			l418:
+				if !_rules[rulespace]() {
+					goto l404
+				}
+			l420:
+				{
+					position421, tokenIndex421 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l421
+					}
+					goto l420
+				l421:
+					position, tokenIndex = position421, tokenIndex421
+				}
+				if !_rules[ruledefault_value]() {
+					goto l404
+				}
+				add(rulecolumn_default, position405)
+			}
+			return true
+		l404:
+			position, tokenIndex = position404, tokenIndex404
+			return false
+		},
+		/* 31 default_value <- <(<(('\'' (('\'' '\'') / (!('\'' / '\r' / '\n') .))* '\'') / parenthesized / (!(' ' / '\t' / '\r' / '\n' / '{' / ',') .)+)> Action16)> */
+		func() bool {
+			position422, tokenIndex422 := position, tokenIndex
+			{
+				position423 := position
+				{
+					position424 := position
+					{
+						position425, tokenIndex425 := position, tokenIndex
+						if buffer[position] != rune('\'') {
+							goto l426
+						}
+						position++
+					l427:
+						{
+							position428, tokenIndex428 := position, tokenIndex
+							{
+								position429, tokenIndex429 := position, tokenIndex
+								if buffer[position] != rune('\'') {
+									goto l430
+								}
+								position++
+								if buffer[position] != rune('\'') {
+									goto l430
+								}
+								position++
+								goto l429
+							l430:
+								position, tokenIndex = position429, tokenIndex429
+								{
+									position431, tokenIndex431 := position, tokenIndex
+									{
+										position432, tokenIndex432 := position, tokenIndex
+										if buffer[position] != rune('\'') {
+											goto l433
+										}
+										position++
+										goto l432
+									l433:
+										position, tokenIndex = position432, tokenIndex432
+										if buffer[position] != rune('\r') {
+											goto l434
+										}
+										position++
+										goto l432
+									l434:
+										position, tokenIndex = position432, tokenIndex432
+										if buffer[position] != rune('\n') {
+											goto l431
+										}
+										position++
+									}
+								l432:
+									goto l428
+								l431:
+									position, tokenIndex = position431, tokenIndex431
+								}
+								if !matchDot() {
+									goto l428
+								}
+							}
+						l429:
+							goto l427
+						l428:
+							position, tokenIndex = position428, tokenIndex428
+						}
+						if buffer[position] != rune('\'') {
+							goto l426
+						}
+						position++
+						goto l425
+					l426:
+						position, tokenIndex = position425, tokenIndex425
+						if !_rules[ruleparenthesized]() {
+							goto l435
+						}
+						goto l425
+					l435:
+						position, tokenIndex = position425, tokenIndex425
+						{
+							position438, tokenIndex438 := position, tokenIndex
+							{
+								position439, tokenIndex439 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l440
+								}
+								position++
+								goto l439
+							l440:
+								position, tokenIndex = position439, tokenIndex439
+								if buffer[position] != rune('\t') {
+									goto l441
+								}
+								position++
+								goto l439
+							l441:
+								position, tokenIndex = position439, tokenIndex439
+								if buffer[position] != rune('\r') {
+									goto l442
+								}
+								position++
+								goto l439
+							l442:
+								position, tokenIndex = position439, tokenIndex439
+								if buffer[position] != rune('\n') {
+									goto l443
+								}
+								position++
+								goto l439
+							l443:
+								position, tokenIndex = position439, tokenIndex439
+								if buffer[position] != rune('{') {
+									goto l444
+								}
+								position++
+								goto l439
+							l444:
+								position, tokenIndex = position439, tokenIndex439
+								if buffer[position] != rune(',') {
+									goto l438
+								}
+								position++
+							}
+						l439:
+							goto l422
+						l438:
+							position, tokenIndex = position438, tokenIndex438
+						}
+						if !matchDot() {
+							goto l422
+						}
+					l436:
+						{
+							position437, tokenIndex437 := position, tokenIndex
+							{
+								position445, tokenIndex445 := position, tokenIndex
+								{
+									position446, tokenIndex446 := position, tokenIndex
+									if buffer[position] != rune(' ') {
+										goto l447
+									}
+									position++
+									goto l446
+								l447:
+									position, tokenIndex = position446, tokenIndex446
+									if buffer[position] != rune('\t') {
+										goto l448
+									}
+									position++
+									goto l446
+								l448:
+									position, tokenIndex = position446, tokenIndex446
+									if buffer[position] != rune('\r') {
+										goto l449
+									}
+									position++
+									goto l446
+								l449:
+									position, tokenIndex = position446, tokenIndex446
+									if buffer[position] != rune('\n') {
+										goto l450
+									}
+									position++
+									goto l446
+								l450:
+									position, tokenIndex = position446, tokenIndex446
+									if buffer[position] != rune('{') {
+										goto l451
+									}
+									position++
+									goto l446
+								l451:
+									position, tokenIndex = position446, tokenIndex446
+									if buffer[position] != rune(',') {
+										goto l445
+									}
+									position++
+								}
+							l446:
+								goto l437
+							l445:
+								position, tokenIndex = position445, tokenIndex445
+							}
+							if !matchDot() {
+								goto l437
+							}
+							goto l436
+						l437:
+							position, tokenIndex = position437, tokenIndex437
+						}
+					}
+				l425:
+					add(rulePegText, position424)
+				}
+				if !_rules[ruleAction16]() {
+					goto l422
+				}
+				add(ruledefault_value, position423)
+			}
+			return true
+		l422:
+			position, tokenIndex = position422, tokenIndex422
+			return false
+		},
+		/* 32 column_check <- <(('c' / 'C') ('h' / 'H') ('e' / 'E') ('c' / 'C') ('k' / 'K') space* check_condition)> */
+		func() bool {
+			position452, tokenIndex452 := position, tokenIndex
+			{
+				position453 := position
+				{
+					position454, tokenIndex454 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l455
+					}
+					position++
+					goto l454
+				l455:
+					position, tokenIndex = position454, tokenIndex454
+					if buffer[position] != rune('C') {
+						goto l452
+					}
+					position++
+				}
+			l454:
+				{
+					position456, tokenIndex456 := position, tokenIndex
+					if buffer[position] != rune('h') {
+						goto l457
+					}
+					position++
+					goto l456
+				l457:
+					position, tokenIndex = position456, tokenIndex456
+					if buffer[position] != rune('H') {
+						goto l452
+					}
+					position++
+				}
+			l456:
+				{
+					position458, tokenIndex458 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l459
+					}
+					position++
+					goto l458
+				l459:
+					position, tokenIndex = position458, tokenIndex458
+					if buffer[position] != rune('E') {
+						goto l452
+					}
+					position++
+				}
+			l458:
+				{
+					position460, tokenIndex460 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l461
+					}
+					position++
+					goto l460
+				l461:
+					position, tokenIndex = position460, tokenIndex460
+					if buffer[position] != rune('C') {
+						goto l452
+					}
+					position++
+				}
+			l460:
+				{
+					position462, tokenIndex462 := position, tokenIndex
+					if buffer[position] != rune('k') {
+						goto l463
+					}
+					position++
+					goto l462
+				l463:
+					position, tokenIndex = position462, tokenIndex462
+					if buffer[position] != rune('K') {
+						goto l452
+					}
+					position++
+				}
+			l462:
+			l464:
+				{
+					position465, tokenIndex465 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l465
+					}
+					goto l464
+				l465:
+					position, tokenIndex = position465, tokenIndex465
+				}
+				if !_rules[rulecheck_condition]() {
+					goto l452
+				}
+				add(rulecolumn_check, position453)
+			}
+			return true
+		l452:
+			position, tokenIndex = position452, tokenIndex452
+			return false
+		},
+		/* 33 check_condition <- <(<parenthesized> Action17)> */
+		func() bool {
+			position466, tokenIndex466 := position, tokenIndex
+			{
+				position467 := position
+				{
+					position468 := position
+					if !_rules[ruleparenthesized]() {
+						goto l466
+					}
+					add(rulePegText, position468)
+				}
+				if !_rules[ruleAction17]() {
+					goto l466
+				}
+				add(rulecheck_condition, position467)
+			}
+			return true
+		l466:
+			position, tokenIndex = position466, tokenIndex466
+			return false
+		},
+		/* 34 parenthesized <- <('(' (parenthesized / (!('(' / ')' / '\r' / '\n') .))* ')')> */
+		func() bool {
+			position469, tokenIndex469 := position, tokenIndex
+			{
+				position470 := position
+				if buffer[position] != rune('(') {
+					goto l469
+				}
+				position++
+			l471:
+				{
+					position472, tokenIndex472 := position, tokenIndex
+					{
+						position473, tokenIndex473 := position, tokenIndex
+						if !_rules[ruleparenthesized]() {
+							goto l474
+						}
+						goto l473
+					l474:
+						position, tokenIndex = position473, tokenIndex473
+						{
+							position475, tokenIndex475 := position, tokenIndex
+							{
+								position476, tokenIndex476 := position, tokenIndex
+								if buffer[position] != rune('(') {
+									goto l477
+								}
+								position++
+								goto l476
+							l477:
+								position, tokenIndex = position476, tokenIndex476
+								if buffer[position] != rune(')') {
+									goto l478
+								}
+								position++
+								goto l476
+							l478:
+								position, tokenIndex = position476, tokenIndex476
+								if buffer[position] != rune('\r') {
+									goto l479
+								}
+								position++
+								goto l476
+							l479:
+								position, tokenIndex = position476, tokenIndex476
+								if buffer[position] != rune('\n') {
+									goto l475
+								}
+								position++
+							}
+						l476:
+							goto l472
+						l475:
+							position, tokenIndex = position475, tokenIndex475
+						}
+						if !matchDot() {
+							goto l472
+						}
+					}
+				l473:
+					goto l471
+				l472:
+					position, tokenIndex = position472, tokenIndex472
+				}
+				if buffer[position] != rune(')') {
+					goto l469
+				}
+				position++
+				add(ruleparenthesized, position470)
+			}
+			return true
+		l469:
+			position, tokenIndex = position469, tokenIndex469
+			return false
+		},
+		/* 35 relation_info <- <(space* relation_left space* cardinality_left relation_operator cardinality_right space* relation_right (ws* '{' ws* (relation_attribute ws* attribute_sep? ws*)* ws* '}')? newline_or_eot Action18)> */
+		func() bool {
+			position480, tokenIndex480 := position, tokenIndex
+			{
+				position481 := position
+			l482:
+				{
+					position483, tokenIndex483 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l483
+					}
+					goto l482
+				l483:
+					position, tokenIndex = position483, tokenIndex483
+				}
+				if !_rules[rulerelation_left]() {
+					goto l480
+				}
+			l484:
+				{
+					position485, tokenIndex485 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l485
+					}
+					goto l484
+				l485:
+					position, tokenIndex = position485, tokenIndex485
+				}
+				if !_rules[rulecardinality_left]() {
+					goto l480
+				}
+				if !_rules[rulerelation_operator]() {
+					goto l480
+				}
+				if !_rules[rulecardinality_right]() {
+					goto l480
+				}
+			l486:
+				{
+					position487, tokenIndex487 := position, tokenIndex
 					if !_rules[rulespace]() {
-						goto l119
+						goto l487
+					}
+					goto l486
+				l487:
+					position, tokenIndex = position487, tokenIndex487
+				}
+				if !_rules[rulerelation_right]() {
+					goto l480
+				}
+				{
+					position488, tokenIndex488 := position, tokenIndex
+				l490:
+					{
+						position491, tokenIndex491 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l491
+						}
+						goto l490
+					l491:
+						position, tokenIndex = position491, tokenIndex491
+					}
+					if buffer[position] != rune('{') {
+						goto l488
+					}
+					position++
+				l492:
+					{
+						position493, tokenIndex493 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l493
+						}
+						goto l492
+					l493:
+						position, tokenIndex = position493, tokenIndex493
+					}
+				l494:
+					{
+						position495, tokenIndex495 := position, tokenIndex
+						if !_rules[rulerelation_attribute]() {
+							goto l495
+						}
+					l496:
+						{
+							position497, tokenIndex497 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l497
+							}
+							goto l496
+						l497:
+							position, tokenIndex = position497, tokenIndex497
+						}
+						{
+							position498, tokenIndex498 := position, tokenIndex
+							if !_rules[ruleattribute_sep]() {
+								goto l498
+							}
+							goto l499
+						l498:
+							position, tokenIndex = position498, tokenIndex498
+						}
+					l499:
+					l500:
+						{
+							position501, tokenIndex501 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l501
+							}
+							goto l500
+						l501:
+							position, tokenIndex = position501, tokenIndex501
+						}
+						goto l494
+					l495:
+						position, tokenIndex = position495, tokenIndex495
+					}
+				l502:
+					{
+						position503, tokenIndex503 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l503
+						}
+						goto l502
+					l503:
+						position, tokenIndex = position503, tokenIndex503
+					}
+					if buffer[position] != rune('}') {
+						goto l488
 					}
-					goto l118
-				l119:
-					position, tokenIndex = position119, tokenIndex119
+					position++
+					goto l489
+				l488:
+					position, tokenIndex = position488, tokenIndex488
 				}
-				if !_rules[rulerelation_left]() {
-					goto l116
+			l489:
+				if !_rules[rulenewline_or_eot]() {
+					goto l480
 				}
-			l120:
+				if !_rules[ruleAction18]() {
+					goto l480
+				}
+				add(rulerelation_info, position481)
+			}
+			return true
+		l480:
+			position, tokenIndex = position480, tokenIndex480
+			return false
+		},
+		/* 36 relation_left <- <(<string> Action19)> */
+		func() bool {
+			position504, tokenIndex504 := position, tokenIndex
+			{
+				position505 := position
 				{
-					position121, tokenIndex121 := position, tokenIndex
-					if !_rules[rulespace]() {
-						goto l121
+					position506 := position
+					if !_rules[rulestring]() {
+						goto l504
 					}
-					goto l120
-				l121:
-					position, tokenIndex = position121, tokenIndex121
+					add(rulePegText, position506)
 				}
-				if !_rules[rulecardinality_left]() {
-					goto l116
+				if !_rules[ruleAction19]() {
+					goto l504
+				}
+				add(rulerelation_left, position505)
+			}
+			return true
+		l504:
+			position, tokenIndex = position504, tokenIndex504
+			return false
+		},
+		/* 37 cardinality_left <- <(<cardinality> Action20)> */
+		func() bool {
+			position507, tokenIndex507 := position, tokenIndex
+			{
+				position508 := position
+				{
+					position509 := position
+					if !_rules[rulecardinality]() {
+						goto l507
+					}
+					add(rulePegText, position509)
+				}
+				if !_rules[ruleAction20]() {
+					goto l507
+				}
+				add(rulecardinality_left, position508)
+			}
+			return true
+		l507:
+			position, tokenIndex = position507, tokenIndex507
+			return false
+		},
+		/* 38 relation_right <- <(<string> Action21)> */
+		func() bool {
+			position510, tokenIndex510 := position, tokenIndex
+			{
+				position511 := position
+				{
+					position512 := position
+					if !_rules[rulestring]() {
+						goto l510
+					}
+					add(rulePegText, position512)
+				}
+				if !_rules[ruleAction21]() {
+					goto l510
+				}
+				add(rulerelation_right, position511)
+			}
+			return true
+		l510:
+			position, tokenIndex = position510, tokenIndex510
+			return false
+		},
+		/* 39 cardinality_right <- <(<cardinality> Action22)> */
+		func() bool {
+			position513, tokenIndex513 := position, tokenIndex
+			{
+				position514 := position
+				{
+					position515 := position
+					if !_rules[rulecardinality]() {
+						goto l513
+					}
+					add(rulePegText, position515)
+				}
+				if !_rules[ruleAction22]() {
+					goto l513
 				}
-				if buffer[position] != rune('-') {
-					goto l116
+				add(rulecardinality_right, position514)
+			}
+			return true
+		l513:
+			position, tokenIndex = position513, tokenIndex513
+			return false
+		},
+		/* 40 relation_operator <- <(<(('-' '-') / ('=' '='))> Action23)> */
+		func() bool {
+			position516, tokenIndex516 := position, tokenIndex
+			{
+				position517 := position
+				{
+					position518 := position
+					{
+						position519, tokenIndex519 := position, tokenIndex
+						if buffer[position] != rune('-') {
+							goto l520
+						}
+						position++
+						if buffer[position] != rune('-') {
+							goto l520
+						}
+						position++
+						goto l519
+					l520:
+						position, tokenIndex = position519, tokenIndex519
+						if buffer[position] != rune('=') {
+							goto l516
+						}
+						position++
+						if buffer[position] != rune('=') {
+							goto l516
+						}
+						position++
+					}
+				l519:
+					add(rulePegText, position518)
+				}
+				if !_rules[ruleAction23]() {
+					goto l516
+				}
+				add(rulerelation_operator, position517)
+			}
+			return true
+		l516:
+			position, tokenIndex = position516, tokenIndex516
+			return false
+		},
+		/* 41 relationship_info <- <('<' relationship_title '>' space* relationship_participant (space* ',' space* relationship_participant)+ (space* '{' ws* (relationship_attribute ws* attribute_sep? ws*)* ws* '}')? space* newline_or_eot (table_column / empty_line)*)> */
+		func() bool {
+			position521, tokenIndex521 := position, tokenIndex
+			{
+				position522 := position
+				if buffer[position] != rune('<') {
+					goto l521
 				}
 				position++
-				if buffer[position] != rune('-') {
-					goto l116
+				if !_rules[rulerelationship_title]() {
+					goto l521
+				}
+				if buffer[position] != rune('>') {
+					goto l521
 				}
 				position++
-				if !_rules[rulecardinality_right]() {
-					goto l116
+			l523:
+				{
+					position524, tokenIndex524 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l524
+					}
+					goto l523
+				l524:
+					position, tokenIndex = position524, tokenIndex524
 				}
-			l122:
+				if !_rules[rulerelationship_participant]() {
+					goto l521
+				}
+			l527:
 				{
-					position123, tokenIndex123 := position, tokenIndex
+					position528, tokenIndex528 := position, tokenIndex
 					if !_rules[rulespace]() {
-						goto l123
+						goto l528
 					}
-					goto l122
-				l123:
-					position, tokenIndex = position123, tokenIndex123
+					goto l527
+				l528:
+					position, tokenIndex = position528, tokenIndex528
 				}
-				if !_rules[rulerelation_right]() {
-					goto l116
+				if buffer[position] != rune(',') {
+					goto l521
+				}
+				position++
+			l529:
+				{
+					position530, tokenIndex530 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l530
+					}
+					goto l529
+				l530:
+					position, tokenIndex = position530, tokenIndex530
 				}
+				if !_rules[rulerelationship_participant]() {
+					goto l521
+				}
+			l525:
 				{
-					position124, tokenIndex124 := position, tokenIndex
-				l126:
+					position526, tokenIndex526 := position, tokenIndex
+				l531:
 					{
-						position127, tokenIndex127 := position, tokenIndex
-						if !_rules[rulews]() {
-							goto l127
+						position532, tokenIndex532 := position, tokenIndex
+						if !_rules[rulespace]() {
+							goto l532
+						}
+						goto l531
+					l532:
+						position, tokenIndex = position532, tokenIndex532
+					}
+					if buffer[position] != rune(',') {
+						goto l526
+					}
+					position++
+				l533:
+					{
+						position534, tokenIndex534 := position, tokenIndex
+						if !_rules[rulespace]() {
+							goto l534
+						}
+						goto l533
+					l534:
+						position, tokenIndex = position534, tokenIndex534
+					}
+					if !_rules[rulerelationship_participant]() {
+						goto l526
+					}
+					goto l525
+				l526:
+					position, tokenIndex = position526, tokenIndex526
+				}
+				{
+					position535, tokenIndex535 := position, tokenIndex
+				l537:
+					{
+						position538, tokenIndex538 := position, tokenIndex
+						if !_rules[rulespace]() {
+							goto l538
 						}
-						goto l126
-					l127:
-						position, tokenIndex = position127, tokenIndex127
+						goto l537
+					l538:
+						position, tokenIndex = position538, tokenIndex538
 					}
 					if buffer[position] != rune('{') {
-						goto l124
+						goto l535
 					}
 					position++
-				l128:
+				l539:
 					{
-						position129, tokenIndex129 := position, tokenIndex
+						position540, tokenIndex540 := position, tokenIndex
 						if !_rules[rulews]() {
-							goto l129
+							goto l540
 						}
-						goto l128
-					l129:
-						position, tokenIndex = position129, tokenIndex129
+						goto l539
+					l540:
+						position, tokenIndex = position540, tokenIndex540
 					}
-				l130:
+				l541:
 					{
-						position131, tokenIndex131 := position, tokenIndex
-						if !_rules[rulerelation_attribute]() {
-							goto l131
+						position542, tokenIndex542 := position, tokenIndex
+						if !_rules[rulerelationship_attribute]() {
+							goto l542
 						}
-					l132:
+					l543:
 						{
-							position133, tokenIndex133 := position, tokenIndex
+							position544, tokenIndex544 := position, tokenIndex
 							if !_rules[rulews]() {
-								goto l133
+								goto l544
 							}
-							goto l132
-						l133:
-							position, tokenIndex = position133, tokenIndex133
+							goto l543
+						l544:
+							position, tokenIndex = position544, tokenIndex544
 						}
 						{
-							position134, tokenIndex134 := position, tokenIndex
+							position545, tokenIndex545 := position, tokenIndex
 							if !_rules[ruleattribute_sep]() {
-								goto l134
+								goto l545
 							}
-							goto l135
-						l134:
-							position, tokenIndex = position134, tokenIndex134
+							goto l546
+						l545:
+							position, tokenIndex = position545, tokenIndex545
 						}
-					l135:
-					l136:
+					l546:
+					l547:
 						{
-							position137, tokenIndex137 := position, tokenIndex
+							position548, tokenIndex548 := position, tokenIndex
 							if !_rules[rulews]() {
-								goto l137
+								goto l548
 							}
-							goto l136
-						l137:
-							position, tokenIndex = position137, tokenIndex137
+							goto l547
+						l548:
+							position, tokenIndex = position548, tokenIndex548
 						}
-						goto l130
-					l131:
-						position, tokenIndex = position131, tokenIndex131
+						goto l541
+					l542:
+						position, tokenIndex = position542, tokenIndex542
 					}
-				l138:
+				l549:
 					{
-						position139, tokenIndex139 := position, tokenIndex
+						position550, tokenIndex550 := position, tokenIndex
 						if !_rules[rulews]() {
-							goto l139
+							goto l550
 						}
-						goto l138
-					l139:
-						position, tokenIndex = position139, tokenIndex139
+						goto l549
+					l550:
+						position, tokenIndex = position550, tokenIndex550
 					}
 					if buffer[position] != rune('}') {
-						goto l124
+						goto l535
+					}
+					position++
+					goto l536
+				l535:
+					position, tokenIndex = position535, tokenIndex535
+				}
+			l536:
+			l551:
+				{
+					position552, tokenIndex552 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l552
+					}
+					goto l551
+				l552:
+					position, tokenIndex = position552, tokenIndex552
+				}
+				if !_rules[rulenewline_or_eot]() {
+					goto l521
+				}
+			l553:
+				{
+					position554, tokenIndex554 := position, tokenIndex
+					{
+						position555, tokenIndex555 := position, tokenIndex
+						if !_rules[ruletable_column]() {
+							goto l556
+						}
+						goto l555
+					l556:
+						position, tokenIndex = position555, tokenIndex555
+						if !_rules[ruleempty_line]() {
+							goto l554
+						}
+					}
+				l555:
+					goto l553
+				l554:
+					position, tokenIndex = position554, tokenIndex554
+				}
+				add(rulerelationship_info, position522)
+			}
+			return true
+		l521:
+			position, tokenIndex = position521, tokenIndex521
+			return false
+		},
+		/* 42 relationship_title <- <(<(!('>' / '"' / '\t' / '\r' / '\n' / '/' / ':' / ',' / '[' / ']' / '{' / '}' / ' ') .)+> Action24)> */
+		func() bool {
+			position557, tokenIndex557 := position, tokenIndex
+			{
+				position558 := position
+				{
+					position559 := position
+					{
+						position562, tokenIndex562 := position, tokenIndex
+						{
+							position563, tokenIndex563 := position, tokenIndex
+							if buffer[position] != rune('>') {
+								goto l564
+							}
+							position++
+							goto l563
+						l564:
+							position, tokenIndex = position563, tokenIndex563
+							if buffer[position] != rune('"') {
+								goto l565
+							}
+							position++
+							goto l563
+						l565:
+							position, tokenIndex = position563, tokenIndex563
+							if buffer[position] != rune('\t') {
+								goto l566
+							}
+							position++
+							goto l563
+						l566:
+							position, tokenIndex = position563, tokenIndex563
+							if buffer[position] != rune('\r') {
+								goto l567
+							}
+							position++
+							goto l563
+						l567:
+							position, tokenIndex = position563, tokenIndex563
+							if buffer[position] != rune('\n') {
+								goto l568
+							}
+							position++
+							goto l563
+						l568:
+							position, tokenIndex = position563, tokenIndex563
+							if buffer[position] != rune('/') {
+								goto l569
+							}
+							position++
+							goto l563
+						l569:
+							position, tokenIndex = position563, tokenIndex563
+							if buffer[position] != rune(':') {
+								goto l570
+							}
+							position++
+							goto l563
+						l570:
+							position, tokenIndex = position563, tokenIndex563
+							if buffer[position] != rune(',') {
+								goto l571
+							}
+							position++
+							goto l563
+						l571:
+							position, tokenIndex = position563, tokenIndex563
+							if buffer[position] != rune('[') {
+								goto l572
+							}
+							position++
+							goto l563
+						l572:
+							position, tokenIndex = position563, tokenIndex563
+							if buffer[position] != rune(']') {
+								goto l573
+							}
+							position++
+							goto l563
+						l573:
+							position, tokenIndex = position563, tokenIndex563
+							if buffer[position] != rune('{') {
+								goto l574
+							}
+							position++
+							goto l563
+						l574:
+							position, tokenIndex = position563, tokenIndex563
+							if buffer[position] != rune('}') {
+								goto l575
+							}
+							position++
+							goto l563
+						l575:
+							position, tokenIndex = position563, tokenIndex563
+							if buffer[position] != rune(' ') {
+								goto l562
+							}
+							position++
+						}
+					l563:
+						goto l557
+					l562:
+						position, tokenIndex = position562, tokenIndex562
+					}
+					if !matchDot() {
+						goto l557
+					}
+				l560:
+					{
+						position561, tokenIndex561 := position, tokenIndex
+						{
+							position576, tokenIndex576 := position, tokenIndex
+							{
+								position577, tokenIndex577 := position, tokenIndex
+								if buffer[position] != rune('>') {
+									goto l578
+								}
+								position++
+								goto l577
+							l578:
+								position, tokenIndex = position577, tokenIndex577
+								if buffer[position] != rune('"') {
+									goto l579
+								}
+								position++
+								goto l577
+							l579:
+								position, tokenIndex = position577, tokenIndex577
+								if buffer[position] != rune('\t') {
+									goto l580
+								}
+								position++
+								goto l577
+							l580:
+								position, tokenIndex = position577, tokenIndex577
+								if buffer[position] != rune('\r') {
+									goto l581
+								}
+								position++
+								goto l577
+							l581:
+								position, tokenIndex = position577, tokenIndex577
+								if buffer[position] != rune('\n') {
+									goto l582
+								}
+								position++
+								goto l577
+							l582:
+								position, tokenIndex = position577, tokenIndex577
+								if buffer[position] != rune('/') {
+									goto l583
+								}
+								position++
+								goto l577
+							l583:
+								position, tokenIndex = position577, tokenIndex577
+								if buffer[position] != rune(':') {
+									goto l584
+								}
+								position++
+								goto l577
+							l584:
+								position, tokenIndex = position577, tokenIndex577
+								if buffer[position] != rune(',') {
+									goto l585
+								}
+								position++
+								goto l577
+							l585:
+								position, tokenIndex = position577, tokenIndex577
+								if buffer[position] != rune('[') {
+									goto l586
+								}
+								position++
+								goto l577
+							l586:
+								position, tokenIndex = position577, tokenIndex577
+								if buffer[position] != rune(']') {
+									goto l587
+								}
+								position++
+								goto l577
+							l587:
+								position, tokenIndex = position577, tokenIndex577
+								if buffer[position] != rune('{') {
+									goto l588
+								}
+								position++
+								goto l577
+							l588:
+								position, tokenIndex = position577, tokenIndex577
+								if buffer[position] != rune('}') {
+									goto l589
+								}
+								position++
+								goto l577
+							l589:
+								position, tokenIndex = position577, tokenIndex577
+								if buffer[position] != rune(' ') {
+									goto l576
+								}
+								position++
+							}
+						l577:
+							goto l561
+						l576:
+							position, tokenIndex = position576, tokenIndex576
+						}
+						if !matchDot() {
+							goto l561
+						}
+						goto l560
+					l561:
+						position, tokenIndex = position561, tokenIndex561
+					}
+					add(rulePegText, position559)
+				}
+				if !_rules[ruleAction24]() {
+					goto l557
+				}
+				add(rulerelationship_title, position558)
+			}
+			return true
+		l557:
+			position, tokenIndex = position557, tokenIndex557
+			return false
+		},
+		/* 43 relationship_participant <- <(participant_table space+ participant_cardinality)> */
+		func() bool {
+			position590, tokenIndex590 := position, tokenIndex
+			{
+				position591 := position
+				if !_rules[ruleparticipant_table]() {
+					goto l590
+				}
+				if !_rules[rulespace]() {
+					goto l590
+				}
+			l592:
+				{
+					position593, tokenIndex593 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l593
+					}
+					goto l592
+				l593:
+					position, tokenIndex = position593, tokenIndex593
+				}
+				if !_rules[ruleparticipant_cardinality]() {
+					goto l590
+				}
+				add(rulerelationship_participant, position591)
+			}
+			return true
+		l590:
+			position, tokenIndex = position590, tokenIndex590
+			return false
+		},
+		/* 44 participant_table <- <(<string> Action25)> */
+		func() bool {
+			position594, tokenIndex594 := position, tokenIndex
+			{
+				position595 := position
+				{
+					position596 := position
+					if !_rules[rulestring]() {
+						goto l594
+					}
+					add(rulePegText, position596)
+				}
+				if !_rules[ruleAction25]() {
+					goto l594
+				}
+				add(ruleparticipant_table, position595)
+			}
+			return true
+		l594:
+			position, tokenIndex = position594, tokenIndex594
+			return false
+		},
+		/* 45 participant_cardinality <- <(<cardinality> Action26)> */
+		func() bool {
+			position597, tokenIndex597 := position, tokenIndex
+			{
+				position598 := position
+				{
+					position599 := position
+					if !_rules[rulecardinality]() {
+						goto l597
+					}
+					add(rulePegText, position599)
+				}
+				if !_rules[ruleAction26]() {
+					goto l597
+				}
+				add(ruleparticipant_cardinality, position598)
+			}
+			return true
+		l597:
+			position, tokenIndex = position597, tokenIndex597
+			return false
+		},
+		/* 46 title_attribute <- <(attribute_key space* ':' space* attribute_value Action27)> */
+		func() bool {
+			position600, tokenIndex600 := position, tokenIndex
+			{
+				position601 := position
+				if !_rules[ruleattribute_key]() {
+					goto l600
+				}
+			l602:
+				{
+					position603, tokenIndex603 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l603
+					}
+					goto l602
+				l603:
+					position, tokenIndex = position603, tokenIndex603
+				}
+				if buffer[position] != rune(':') {
+					goto l600
+				}
+				position++
+			l604:
+				{
+					position605, tokenIndex605 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l605
 					}
-					position++
-					goto l125
-				l124:
-					position, tokenIndex = position124, tokenIndex124
+					goto l604
+				l605:
+					position, tokenIndex = position605, tokenIndex605
 				}
-			l125:
-				if !_rules[rulenewline_or_eot]() {
-					goto l116
+				if !_rules[ruleattribute_value]() {
+					goto l600
 				}
-				if !_rules[ruleAction6]() {
-					goto l116
+				if !_rules[ruleAction27]() {
+					goto l600
 				}
-				add(rulerelation_info, position117)
+				add(ruletitle_attribute, position601)
 			}
 			return true
-		l116:
-			position, tokenIndex = position116, tokenIndex116
+		l600:
+			position, tokenIndex = position600, tokenIndex600
 			return false
 		},
-		/* 13 relation_left <- <(<string> Action7)> */
+		/* 47 graph_attribute <- <(attribute_key space* ':' space* attribute_value Action28)> */
 		func() bool {
-			position140, tokenIndex140 := position, tokenIndex
+			position606, tokenIndex606 := position, tokenIndex
 			{
-				position141 := position
+				position607 := position
+				if !_rules[ruleattribute_key]() {
+					goto l606
+				}
+			l608:
 				{
-					position142 := position
-					if !_rules[rulestring]() {
-						goto l140
+					position609, tokenIndex609 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l609
 					}
-					add(rulePegText, position142)
+					goto l608
+				l609:
+					position, tokenIndex = position609, tokenIndex609
 				}
-				if !_rules[ruleAction7]() {
-					goto l140
+				if buffer[position] != rune(':') {
+					goto l606
 				}
-				add(rulerelation_left, position141)
-			}
-			return true
-		l140:
-			position, tokenIndex = position140, tokenIndex140
-			return false
-		},
-		/* 14 cardinality_left <- <(<cardinality> Action8)> */
-		func() bool {
-			position143, tokenIndex143 := position, tokenIndex
-			{
-				position144 := position
+				position++
+			l610:
 				{
-					position145 := position
-					if !_rules[rulecardinality]() {
-						goto l143
+					position611, tokenIndex611 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l611
 					}
-					add(rulePegText, position145)
+					goto l610
+				l611:
+					position, tokenIndex = position611, tokenIndex611
 				}
-				if !_rules[ruleAction8]() {
-					goto l143
+				if !_rules[ruleattribute_value]() {
+					goto l606
+				}
+				if !_rules[ruleAction28]() {
+					goto l606
 				}
-				add(rulecardinality_left, position144)
+				add(rulegraph_attribute, position607)
 			}
 			return true
-		l143:
-			position, tokenIndex = position143, tokenIndex143
+		l606:
+			position, tokenIndex = position606, tokenIndex606
 			return false
 		},
-		/* 15 relation_right <- <(<string> Action9)> */
+		/* 48 table_attribute <- <(attribute_key space* ':' space* attribute_value Action29)> */
 		func() bool {
-			position146, tokenIndex146 := position, tokenIndex
+			position612, tokenIndex612 := position, tokenIndex
 			{
-				position147 := position
+				position613 := position
+				if !_rules[ruleattribute_key]() {
+					goto l612
+				}
+			l614:
 				{
-					position148 := position
-					if !_rules[rulestring]() {
-						goto l146
+					position615, tokenIndex615 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l615
 					}
-					add(rulePegText, position148)
+					goto l614
+				l615:
+					position, tokenIndex = position615, tokenIndex615
 				}
-				if !_rules[ruleAction9]() {
-					goto l146
+				if buffer[position] != rune(':') {
+					goto l612
+				}
+				position++
+			l616:
+				{
+					position617, tokenIndex617 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l617
+					}
+					goto l616
+				l617:
+					position, tokenIndex = position617, tokenIndex617
 				}
-				add(rulerelation_right, position147)
+				if !_rules[ruleattribute_value]() {
+					goto l612
+				}
+				if !_rules[ruleAction29]() {
+					goto l612
+				}
+				add(ruletable_attribute, position613)
 			}
 			return true
-		l146:
-			position, tokenIndex = position146, tokenIndex146
+		l612:
+			position, tokenIndex = position612, tokenIndex612
 			return false
 		},
-		/* 16 cardinality_right <- <(<cardinality> Action10)> */
+		/* 49 column_attribute <- <(attribute_key space* ':' space* attribute_value Action30)> */
 		func() bool {
-			position149, tokenIndex149 := position, tokenIndex
+			position618, tokenIndex618 := position, tokenIndex
 			{
-				position150 := position
+				position619 := position
+				if !_rules[ruleattribute_key]() {
+					goto l618
+				}
+			l620:
 				{
-					position151 := position
-					if !_rules[rulecardinality]() {
-						goto l149
+					position621, tokenIndex621 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l621
 					}
-					add(rulePegText, position151)
+					goto l620
+				l621:
+					position, tokenIndex = position621, tokenIndex621
 				}
-				if !_rules[ruleAction10]() {
-					goto l149
+				if buffer[position] != rune(':') {
+					goto l618
+				}
+				position++
+			l622:
+				{
+					position623, tokenIndex623 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l623
+					}
+					goto l622
+				l623:
+					position, tokenIndex = position623, tokenIndex623
+				}
+				if !_rules[ruleattribute_value]() {
+					goto l618
 				}
-				add(rulecardinality_right, position150)
+				if !_rules[ruleAction30]() {
+					goto l618
+				}
+				add(rulecolumn_attribute, position619)
 			}
 			return true
-		l149:
-			position, tokenIndex = position149, tokenIndex149
+		l618:
+			position, tokenIndex = position618, tokenIndex618
 			return false
 		},
-		/* 17 title_attribute <- <(attribute_key space* ':' space* attribute_value Action11)> */
+		/* 50 group_attribute <- <(attribute_key space* ':' space* attribute_value Action31)> */
 		func() bool {
-			position152, tokenIndex152 := position, tokenIndex
+			position624, tokenIndex624 := position, tokenIndex
 			{
-				position153 := position
+				position625 := position
 				if !_rules[ruleattribute_key]() {
-					goto l152
+					goto l624
 				}
-			l154:
+			l626:
 				{
-					position155, tokenIndex155 := position, tokenIndex
+					position627, tokenIndex627 := position, tokenIndex
 					if !_rules[rulespace]() {
-						goto l155
+						goto l627
 					}
-					goto l154
-				l155:
-					position, tokenIndex = position155, tokenIndex155
+					goto l626
+				l627:
+					position, tokenIndex = position627, tokenIndex627
 				}
 				if buffer[position] != rune(':') {
-					goto l152
+					goto l624
 				}
 				position++
-			l156:
+			l628:
 				{
-					position157, tokenIndex157 := position, tokenIndex
+					position629, tokenIndex629 := position, tokenIndex
 					if !_rules[rulespace]() {
-						goto l157
+						goto l629
 					}
-					goto l156
-				l157:
-					position, tokenIndex = position157, tokenIndex157
+					goto l628
+				l629:
+					position, tokenIndex = position629, tokenIndex629
 				}
 				if !_rules[ruleattribute_value]() {
-					goto l152
+					goto l624
 				}
-				if !_rules[ruleAction11]() {
-					goto l152
+				if !_rules[ruleAction31]() {
+					goto l624
 				}
-				add(ruletitle_attribute, position153)
+				add(rulegroup_attribute, position625)
 			}
 			return true
-		l152:
-			position, tokenIndex = position152, tokenIndex152
+		l624:
+			position, tokenIndex = position624, tokenIndex624
 			return false
 		},
-		/* 18 table_attribute <- <(attribute_key space* ':' space* attribute_value Action12)> */
+		/* 51 relation_attribute <- <(attribute_key space* ':' space* attribute_value Action32)> */
 		func() bool {
-			position158, tokenIndex158 := position, tokenIndex
+			position630, tokenIndex630 := position, tokenIndex
 			{
-				position159 := position
+				position631 := position
 				if !_rules[ruleattribute_key]() {
-					goto l158
+					goto l630
 				}
-			l160:
+			l632:
 				{
-					position161, tokenIndex161 := position, tokenIndex
+					position633, tokenIndex633 := position, tokenIndex
 					if !_rules[rulespace]() {
-						goto l161
+						goto l633
 					}
-					goto l160
-				l161:
-					position, tokenIndex = position161, tokenIndex161
+					goto l632
+				l633:
+					position, tokenIndex = position633, tokenIndex633
 				}
 				if buffer[position] != rune(':') {
-					goto l158
+					goto l630
 				}
 				position++
-			l162:
+			l634:
 				{
-					position163, tokenIndex163 := position, tokenIndex
+					position635, tokenIndex635 := position, tokenIndex
 					if !_rules[rulespace]() {
-						goto l163
+						goto l635
 					}
-					goto l162
-				l163:
-					position, tokenIndex = position163, tokenIndex163
+					goto l634
+				l635:
+					position, tokenIndex = position635, tokenIndex635
 				}
 				if !_rules[ruleattribute_value]() {
-					goto l158
+					goto l630
 				}
-				if !_rules[ruleAction12]() {
-					goto l158
+				if !_rules[ruleAction32]() {
+					goto l630
 				}
-				add(ruletable_attribute, position159)
+				add(rulerelation_attribute, position631)
 			}
 			return true
-		l158:
-			position, tokenIndex = position158, tokenIndex158
+		l630:
+			position, tokenIndex = position630, tokenIndex630
 			return false
 		},
-		/* 19 column_attribute <- <(attribute_key space* ':' space* attribute_value Action13)> */
+		/* 52 note_attribute <- <(attribute_key space* ':' space* attribute_value Action33)> */
 		func() bool {
-			position164, tokenIndex164 := position, tokenIndex
+			position636, tokenIndex636 := position, tokenIndex
 			{
-				position165 := position
+				position637 := position
 				if !_rules[ruleattribute_key]() {
-					goto l164
+					goto l636
 				}
-			l166:
+			l638:
 				{
-					position167, tokenIndex167 := position, tokenIndex
+					position639, tokenIndex639 := position, tokenIndex
 					if !_rules[rulespace]() {
-						goto l167
+						goto l639
 					}
-					goto l166
-				l167:
-					position, tokenIndex = position167, tokenIndex167
+					goto l638
+				l639:
+					position, tokenIndex = position639, tokenIndex639
 				}
 				if buffer[position] != rune(':') {
-					goto l164
+					goto l636
 				}
 				position++
-			l168:
+			l640:
 				{
-					position169, tokenIndex169 := position, tokenIndex
+					position641, tokenIndex641 := position, tokenIndex
 					if !_rules[rulespace]() {
-						goto l169
+						goto l641
 					}
-					goto l168
-				l169:
-					position, tokenIndex = position169, tokenIndex169
+					goto l640
+				l641:
+					position, tokenIndex = position641, tokenIndex641
 				}
 				if !_rules[ruleattribute_value]() {
-					goto l164
+					goto l636
 				}
-				if !_rules[ruleAction13]() {
-					goto l164
+				if !_rules[ruleAction33]() {
+					goto l636
 				}
-				add(rulecolumn_attribute, position165)
+				add(rulenote_attribute, position637)
 			}
 			return true
-		l164:
-			position, tokenIndex = position164, tokenIndex164
+		l636:
+			position, tokenIndex = position636, tokenIndex636
 			return false
 		},
-		/* 20 relation_attribute <- <(attribute_key space* ':' space* attribute_value Action14)> */
+		/* 53 relationship_attribute <- <(attribute_key space* ':' space* attribute_value Action34)> */
 		func() bool {
-			position170, tokenIndex170 := position, tokenIndex
+			position642, tokenIndex642 := position, tokenIndex
 			{
-				position171 := position
+				position643 := position
 				if !_rules[ruleattribute_key]() {
-					goto l170
+					goto l642
 				}
-			l172:
+			l644:
 				{
-					position173, tokenIndex173 := position, tokenIndex
+					position645, tokenIndex645 := position, tokenIndex
 					if !_rules[rulespace]() {
-						goto l173
+						goto l645
 					}
-					goto l172
-				l173:
-					position, tokenIndex = position173, tokenIndex173
+					goto l644
+				l645:
+					position, tokenIndex = position645, tokenIndex645
 				}
 				if buffer[position] != rune(':') {
-					goto l170
+					goto l642
 				}
 				position++
-			l174:
+			l646:
 				{
-					position175, tokenIndex175 := position, tokenIndex
+					position647, tokenIndex647 := position, tokenIndex
 					if !_rules[rulespace]() {
-						goto l175
+						goto l647
 					}
-					goto l174
-				l175:
-					position, tokenIndex = position175, tokenIndex175
+					goto l646
+				l647:
+					position, tokenIndex = position647, tokenIndex647
 				}
 				if !_rules[ruleattribute_value]() {
-					goto l170
+					goto l642
 				}
-				if !_rules[ruleAction14]() {
-					goto l170
+				if !_rules[ruleAction34]() {
+					goto l642
 				}
-				add(rulerelation_attribute, position171)
+				add(rulerelationship_attribute, position643)
 			}
 			return true
-		l170:
-			position, tokenIndex = position170, tokenIndex170
+		l642:
+			position, tokenIndex = position642, tokenIndex642
 			return false
 		},
-		/* 21 attribute_key <- <(<string> Action15)> */
+		/* 54 attribute_key <- <(<string> Action35)> */
 		func() bool {
-			position176, tokenIndex176 := position, tokenIndex
+			position648, tokenIndex648 := position, tokenIndex
 			{
-				position177 := position
+				position649 := position
 				{
-					position178 := position
+					position650 := position
 					if !_rules[rulestring]() {
-						goto l176
+						goto l648
 					}
-					add(rulePegText, position178)
+					add(rulePegText, position650)
 				}
-				if !_rules[ruleAction15]() {
-					goto l176
+				if !_rules[ruleAction35]() {
+					goto l648
 				}
-				add(ruleattribute_key, position177)
+				add(ruleattribute_key, position649)
 			}
 			return true
-		l176:
-			position, tokenIndex = position176, tokenIndex176
+		l648:
+			position, tokenIndex = position648, tokenIndex648
 			return false
 		},
-		/* 22 attribute_value <- <(bare_value / quoted_value)> */
+		/* 55 attribute_value <- <(bare_value / quoted_value)> */
 		func() bool {
-			position179, tokenIndex179 := position, tokenIndex
+			position651, tokenIndex651 := position, tokenIndex
 			{
-				position180 := position
+				position652 := position
 				{
-					position181, tokenIndex181 := position, tokenIndex
+					position653, tokenIndex653 := position, tokenIndex
 					if !_rules[rulebare_value]() {
-						goto l182
+						goto l654
 					}
-					goto l181
-				l182:
-					position, tokenIndex = position181, tokenIndex181
+					goto l653
+				l654:
+					position, tokenIndex = position653, tokenIndex653
 					if !_rules[rulequoted_value]() {
-						goto l179
+						goto l651
 					}
 				}
-			l181:
-				add(ruleattribute_value, position180)
+			l653:
+				add(ruleattribute_value, position652)
 			}
 			return true
-		l179:
-			position, tokenIndex = position179, tokenIndex179
+		l651:
+			position, tokenIndex = position651, tokenIndex651
 			return false
 		},
-		/* 23 bare_value <- <(<string> Action16)> */
+		/* 56 bare_value <- <(<string> Action36)> */
 		func() bool {
-			position183, tokenIndex183 := position, tokenIndex
+			position655, tokenIndex655 := position, tokenIndex
 			{
-				position184 := position
+				position656 := position
 				{
-					position185 := position
+					position657 := position
 					if !_rules[rulestring]() {
-						goto l183
+						goto l655
 					}
-					add(rulePegText, position185)
+					add(rulePegText, position657)
 				}
-				if !_rules[ruleAction16]() {
-					goto l183
+				if !_rules[ruleAction36]() {
+					goto l655
 				}
-				add(rulebare_value, position184)
+				add(rulebare_value, position656)
 			}
 			return true
-		l183:
-			position, tokenIndex = position183, tokenIndex183
+		l655:
+			position, tokenIndex = position655, tokenIndex655
 			return false
 		},
-		/* 24 quoted_value <- <(<('"' string_in_quote '"')> Action17)> */
+		/* 57 quoted_value <- <(<('"' string_in_quote '"')> Action37)> */
 		func() bool {
-			position186, tokenIndex186 := position, tokenIndex
+			position658, tokenIndex658 := position, tokenIndex
 			{
-				position187 := position
+				position659 := position
 				{
-					position188 := position
+					position660 := position
 					if buffer[position] != rune('"') {
-						goto l186
+						goto l658
 					}
 					position++
 					if !_rules[rulestring_in_quote]() {
-						goto l186
+						goto l658
 					}
 					if buffer[position] != rune('"') {
-						goto l186
+						goto l658
 					}
 					position++
-					add(rulePegText, position188)
+					add(rulePegText, position660)
 				}
-				if !_rules[ruleAction17]() {
-					goto l186
+				if !_rules[ruleAction37]() {
+					goto l658
 				}
-				add(rulequoted_value, position187)
+				add(rulequoted_value, position659)
 			}
 			return true
-		l186:
-			position, tokenIndex = position186, tokenIndex186
+		l658:
+			position, tokenIndex = position658, tokenIndex658
 			return false
 		},
-		/* 25 attribute_sep <- <(space* ',' space*)> */
+		/* 58 attribute_sep <- <(space* ',' space*)> */
 		func() bool {
-			position189, tokenIndex189 := position, tokenIndex
+			position661, tokenIndex661 := position, tokenIndex
 			{
-				position190 := position
-			l191:
+				position662 := position
+			l663:
 				{
-					position192, tokenIndex192 := position, tokenIndex
+					position664, tokenIndex664 := position, tokenIndex
 					if !_rules[rulespace]() {
-						goto l192
+						goto l664
 					}
-					goto l191
-				l192:
-					position, tokenIndex = position192, tokenIndex192
+					goto l663
+				l664:
+					position, tokenIndex = position664, tokenIndex664
 				}
 				if buffer[position] != rune(',') {
-					goto l189
+					goto l661
 				}
 				position++
-			l193:
+			l665:
 				{
-					position194, tokenIndex194 := position, tokenIndex
+					position666, tokenIndex666 := position, tokenIndex
 					if !_rules[rulespace]() {
-						goto l194
+						goto l666
 					}
-					goto l193
-				l194:
-					position, tokenIndex = position194, tokenIndex194
+					goto l665
+				l666:
+					position, tokenIndex = position666, tokenIndex666
 				}
-				add(ruleattribute_sep, position190)
+				add(ruleattribute_sep, position662)
 			}
 			return true
-		l189:
-			position, tokenIndex = position189, tokenIndex189
+		l661:
+			position, tokenIndex = position661, tokenIndex661
 			return false
 		},
-		/* 26 comment_string <- <(!('\r' / '\n') .)*> */
+		/* 59 comment_string <- <(!('\r' / '\n') .)*> */
 		func() bool {
 			{
-				position196 := position
-			l197:
+				position668 := position
+			l669:
 				{
-					position198, tokenIndex198 := position, tokenIndex
+					position670, tokenIndex670 := position, tokenIndex
 					{
-						position199, tokenIndex199 := position, tokenIndex
+						position671, tokenIndex671 := position, tokenIndex
 						{
-							position200, tokenIndex200 := position, tokenIndex
+							position672, tokenIndex672 := position, tokenIndex
 							if buffer[position] != rune('\r') {
-								goto l201
+								goto l673
 							}
 							position++
-							goto l200
-						l201:
-							position, tokenIndex = position200, tokenIndex200
+							goto l672
+						l673:
+							position, tokenIndex = position672, tokenIndex672
 							if buffer[position] != rune('\n') {
-								goto l199
+								goto l671
 							}
 							position++
 						}
-					l200:
-						goto l198
-					l199:
-						position, tokenIndex = position199, tokenIndex199
+					l672:
+						goto l670
+					l671:
+						position, tokenIndex = position671, tokenIndex671
 					}
 					if !matchDot() {
-						goto l198
+						goto l670
 					}
-					goto l197
-				l198:
-					position, tokenIndex = position198, tokenIndex198
+					goto l669
+				l670:
+					position, tokenIndex = position670, tokenIndex670
 				}
-				add(rulecomment_string, position196)
+				add(rulecomment_string, position668)
 			}
 			return true
 		},
-		/* 27 ws <- <(' ' / '\t' / '\r' / '\n')+> */
+		/* 60 ws <- <(' ' / '\t' / '\r' / '\n')+> */
 		func() bool {
-			position202, tokenIndex202 := position, tokenIndex
+			position674, tokenIndex674 := position, tokenIndex
 			{
-				position203 := position
+				position675 := position
 				{
-					position206, tokenIndex206 := position, tokenIndex
+					position678, tokenIndex678 := position, tokenIndex
 					if buffer[position] != rune(' ') {
-						goto l207
+						goto l679
 					}
 					position++
-					goto l206
-				l207:
-					position, tokenIndex = position206, tokenIndex206
+					goto l678
+				l679:
+					position, tokenIndex = position678, tokenIndex678
 					if buffer[position] != rune('\t') {
-						goto l208
+						goto l680
 					}
 					position++
-					goto l206
-				l208:
-					position, tokenIndex = position206, tokenIndex206
+					goto l678
+				l680:
+					position, tokenIndex = position678, tokenIndex678
 					if buffer[position] != rune('\r') {
-						goto l209
+						goto l681
 					}
 					position++
-					goto l206
-				l209:
-					position, tokenIndex = position206, tokenIndex206
+					goto l678
+				l681:
+					position, tokenIndex = position678, tokenIndex678
 					if buffer[position] != rune('\n') {
-						goto l202
+						goto l674
 					}
 					position++
 				}
-			l206:
-			l204:
+			l678:
+			l676:
 				{
-					position205, tokenIndex205 := position, tokenIndex
+					position677, tokenIndex677 := position, tokenIndex
 					{
-						position210, tokenIndex210 := position, tokenIndex
+						position682, tokenIndex682 := position, tokenIndex
 						if buffer[position] != rune(' ') {
-							goto l211
+							goto l683
 						}
 						position++
-						goto l210
-					l211:
-						position, tokenIndex = position210, tokenIndex210
+						goto l682
+					l683:
+						position, tokenIndex = position682, tokenIndex682
 						if buffer[position] != rune('\t') {
-							goto l212
+							goto l684
 						}
 						position++
-						goto l210
-					l212:
-						position, tokenIndex = position210, tokenIndex210
+						goto l682
+					l684:
+						position, tokenIndex = position682, tokenIndex682
 						if buffer[position] != rune('\r') {
-							goto l213
+							goto l685
 						}
 						position++
-						goto l210
-					l213:
-						position, tokenIndex = position210, tokenIndex210
+						goto l682
+					l685:
+						position, tokenIndex = position682, tokenIndex682
 						if buffer[position] != rune('\n') {
-							goto l205
+							goto l677
 						}
 						position++
 					}
-				l210:
-					goto l204
-				l205:
-					position, tokenIndex = position205, tokenIndex205
+				l682:
+					goto l676
+				l677:
+					position, tokenIndex = position677, tokenIndex677
 				}
-				add(rulews, position203)
+				add(rulews, position675)
 			}
 			return true
-		l202:
-			position, tokenIndex = position202, tokenIndex202
+		l674:
+			position, tokenIndex = position674, tokenIndex674
 			return false
 		},
-		/* 28 newline <- <(('\r' '\n') / '\n' / '\r')> */
+		/* 61 newline <- <(('\r' '\n') / '\n' / '\r')> */
 		func() bool {
-			position214, tokenIndex214 := position, tokenIndex
+			position686, tokenIndex686 := position, tokenIndex
 			{
-				position215 := position
+				position687 := position
 				{
-					position216, tokenIndex216 := position, tokenIndex
+					position688, tokenIndex688 := position, tokenIndex
 					if buffer[position] != rune('\r') {
-						goto l217
+						goto l689
 					}
 					position++
 					if buffer[position] != rune('\n') {
-						goto l217
+						goto l689
 					}
 					position++
-					goto l216
-				l217:
-					position, tokenIndex = position216, tokenIndex216
+					goto l688
+				l689:
+					position, tokenIndex = position688, tokenIndex688
 					if buffer[position] != rune('\n') {
-						goto l218
+						goto l690
 					}
 					position++
-					goto l216
-				l218:
-					position, tokenIndex = position216, tokenIndex216
+					goto l688
+				l690:
+					position, tokenIndex = position688, tokenIndex688
 					if buffer[position] != rune('\r') {
-						goto l214
+						goto l686
 					}
 					position++
 				}
-			l216:
-				add(rulenewline, position215)
+			l688:
+				add(rulenewline, position687)
 			}
 			return true
-		l214:
-			position, tokenIndex = position214, tokenIndex214
+		l686:
+			position, tokenIndex = position686, tokenIndex686
 			return false
 		},
-		/* 29 newline_or_eot <- <(newline / EOT)> */
+		/* 62 newline_or_eot <- <(newline / EOT)> */
 		func() bool {
-			position219, tokenIndex219 := position, tokenIndex
+			position691, tokenIndex691 := position, tokenIndex
 			{
-				position220 := position
+				position692 := position
 				{
-					position221, tokenIndex221 := position, tokenIndex
+					position693, tokenIndex693 := position, tokenIndex
 					if !_rules[rulenewline]() {
-						goto l222
+						goto l694
 					}
-					goto l221
-				l222:
-					position, tokenIndex = position221, tokenIndex221
+					goto l693
+				l694:
+					position, tokenIndex = position693, tokenIndex693
 					if !_rules[ruleEOT]() {
-						goto l219
+						goto l691
 					}
 				}
-			l221:
-				add(rulenewline_or_eot, position220)
+			l693:
+				add(rulenewline_or_eot, position692)
 			}
 			return true
-		l219:
-			position, tokenIndex = position219, tokenIndex219
+		l691:
+			position, tokenIndex = position691, tokenIndex691
 			return false
 		},
-		/* 30 space <- <(' ' / '\t')+> */
+		/* 63 space <- <(' ' / '\t')+> */
 		func() bool {
-			position223, tokenIndex223 := position, tokenIndex
+			position695, tokenIndex695 := position, tokenIndex
 			{
-				position224 := position
+				position696 := position
 				{
-					position227, tokenIndex227 := position, tokenIndex
+					position699, tokenIndex699 := position, tokenIndex
 					if buffer[position] != rune(' ') {
-						goto l228
+						goto l700
 					}
 					position++
-					goto l227
-				l228:
-					position, tokenIndex = position227, tokenIndex227
+					goto l699
+				l700:
+					position, tokenIndex = position699, tokenIndex699
 					if buffer[position] != rune('\t') {
-						goto l223
+						goto l695
 					}
 					position++
 				}
-			l227:
-			l225:
+			l699:
+			l697:
 				{
-					position226, tokenIndex226 := position, tokenIndex
+					position698, tokenIndex698 := position, tokenIndex
 					{
-						position229, tokenIndex229 := position, tokenIndex
+						position701, tokenIndex701 := position, tokenIndex
 						if buffer[position] != rune(' ') {
-							goto l230
+							goto l702
 						}
 						position++
-						goto l229
-					l230:
-						position, tokenIndex = position229, tokenIndex229
+						goto l701
+					l702:
+						position, tokenIndex = position701, tokenIndex701
 						if buffer[position] != rune('\t') {
-							goto l226
+							goto l698
 						}
 						position++
 					}
-				l229:
-					goto l225
-				l226:
-					position, tokenIndex = position226, tokenIndex226
+				l701:
+					goto l697
+				l698:
+					position, tokenIndex = position698, tokenIndex698
 				}
-				add(rulespace, position224)
+				add(rulespace, position696)
 			}
 			return true
-		l223:
-			position, tokenIndex = position223, tokenIndex223
+		l695:
+			position, tokenIndex = position695, tokenIndex695
 			return false
 		},
-		/* 31 string <- <(!('"' / '\t' / '\r' / '\n' / '/' / ':' / ',' / '[' / ']' / '{' / '}' / ' ') .)+> */
+		/* 64 string <- <(!('"' / '\t' / '\r' / '\n' / '/' / ':' / ',' / '[' / ']' / '{' / '}' / ' ') .)+> */
 		func() bool {
-			position231, tokenIndex231 := position, tokenIndex
+			position703, tokenIndex703 := position, tokenIndex
 			{
-				position232 := position
+				position704 := position
 				{
-					position235, tokenIndex235 := position, tokenIndex
+					position707, tokenIndex707 := position, tokenIndex
 					{
-						position236, tokenIndex236 := position, tokenIndex
+						position708, tokenIndex708 := position, tokenIndex
 						if buffer[position] != rune('"') {
-							goto l237
+							goto l709
 						}
 						position++
-						goto l236
-					l237:
-						position, tokenIndex = position236, tokenIndex236
+						goto l708
+					l709:
+						position, tokenIndex = position708, tokenIndex708
 						if buffer[position] != rune('\t') {
-							goto l238
+							goto l710
 						}
 						position++
-						goto l236
-					l238:
-						position, tokenIndex = position236, tokenIndex236
+						goto l708
+					l710:
+						position, tokenIndex = position708, tokenIndex708
 						if buffer[position] != rune('\r') {
-							goto l239
+							goto l711
 						}
 						position++
-						goto l236
-					l239:
-						position, tokenIndex = position236, tokenIndex236
+						goto l708
+					l711:
+						position, tokenIndex = position708, tokenIndex708
 						if buffer[position] != rune('\n') {
-							goto l240
+							goto l712
 						}
 						position++
-						goto l236
-					l240:
-						position, tokenIndex = position236, tokenIndex236
+						goto l708
+					l712:
+						position, tokenIndex = position708, tokenIndex708
 						if buffer[position] != rune('/') {
-							goto l241
+							goto l713
 						}
 						position++
-						goto l236
-					l241:
-						position, tokenIndex = position236, tokenIndex236
+						goto l708
+					l713:
+						position, tokenIndex = position708, tokenIndex708
 						if buffer[position] != rune(':') {
-							goto l242
+							goto l714
 						}
 						position++
-						goto l236
-					l242:
-						position, tokenIndex = position236, tokenIndex236
+						goto l708
+					l714:
+						position, tokenIndex = position708, tokenIndex708
 						if buffer[position] != rune(',') {
-							goto l243
+							goto l715
 						}
 						position++
-						goto l236
-					l243:
-						position, tokenIndex = position236, tokenIndex236
+						goto l708
+					l715:
+						position, tokenIndex = position708, tokenIndex708
 						if buffer[position] != rune('[') {
-							goto l244
+							goto l716
 						}
 						position++
-						goto l236
-					l244:
-						position, tokenIndex = position236, tokenIndex236
+						goto l708
+					l716:
+						position, tokenIndex = position708, tokenIndex708
 						if buffer[position] != rune(']') {
-							goto l245
+							goto l717
 						}
 						position++
-						goto l236
-					l245:
-						position, tokenIndex = position236, tokenIndex236
+						goto l708
+					l717:
+						position, tokenIndex = position708, tokenIndex708
 						if buffer[position] != rune('{') {
-							goto l246
+							goto l718
 						}
 						position++
-						goto l236
-					l246:
-						position, tokenIndex = position236, tokenIndex236
+						goto l708
+					l718:
+						position, tokenIndex = position708, tokenIndex708
 						if buffer[position] != rune('}') {
-							goto l247
+							goto l719
 						}
 						position++
-						goto l236
-					l247:
-						position, tokenIndex = position236, tokenIndex236
+						goto l708
+					l719:
+						position, tokenIndex = position708, tokenIndex708
 						if buffer[position] != rune(' ') {
-							goto l235
+							goto l707
 						}
 						position++
 					}
-				l236:
-					goto l231
-				l235:
-					position, tokenIndex = position235, tokenIndex235
+				l708:
+					goto l703
+				l707:
+					position, tokenIndex = position707, tokenIndex707
 				}
 				if !matchDot() {
-					goto l231
+					goto l703
 				}
-			l233:
+			l705:
 				{
-					position234, tokenIndex234 := position, tokenIndex
+					position706, tokenIndex706 := position, tokenIndex
 					{
-						position248, tokenIndex248 := position, tokenIndex
+						position720, tokenIndex720 := position, tokenIndex
 						{
-							position249, tokenIndex249 := position, tokenIndex
+							position721, tokenIndex721 := position, tokenIndex
 							if buffer[position] != rune('"') {
-								goto l250
+								goto l722
 							}
 							position++
-							goto l249
-						l250:
-							position, tokenIndex = position249, tokenIndex249
+							goto l721
+						l722:
+							position, tokenIndex = position721, tokenIndex721
 							if buffer[position] != rune('\t') {
-								goto l251
+								goto l723
 							}
 							position++
-							goto l249
-						l251:
-							position, tokenIndex = position249, tokenIndex249
+							goto l721
+						l723:
+							position, tokenIndex = position721, tokenIndex721
 							if buffer[position] != rune('\r') {
-								goto l252
+								goto l724
 							}
 							position++
-							goto l249
-						l252:
-							position, tokenIndex = position249, tokenIndex249
+							goto l721
+						l724:
+							position, tokenIndex = position721, tokenIndex721
 							if buffer[position] != rune('\n') {
-								goto l253
+								goto l725
 							}
 							position++
-							goto l249
-						l253:
-							position, tokenIndex = position249, tokenIndex249
+							goto l721
+						l725:
+							position, tokenIndex = position721, tokenIndex721
 							if buffer[position] != rune('/') {
-								goto l254
+								goto l726
 							}
 							position++
-							goto l249
-						l254:
-							position, tokenIndex = position249, tokenIndex249
+							goto l721
+						l726:
+							position, tokenIndex = position721, tokenIndex721
 							if buffer[position] != rune(':') {
-								goto l255
+								goto l727
 							}
 							position++
-							goto l249
-						l255:
-							position, tokenIndex = position249, tokenIndex249
+							goto l721
+						l727:
+							position, tokenIndex = position721, tokenIndex721
 							if buffer[position] != rune(',') {
-								goto l256
+								goto l728
 							}
 							position++
-							goto l249
-						l256:
-							position, tokenIndex = position249, tokenIndex249
+							goto l721
+						l728:
+							position, tokenIndex = position721, tokenIndex721
 							if buffer[position] != rune('[') {
-								goto l257
+								goto l729
 							}
 							position++
-							goto l249
-						l257:
-							position, tokenIndex = position249, tokenIndex249
+							goto l721
+						l729:
+							position, tokenIndex = position721, tokenIndex721
 							if buffer[position] != rune(']') {
-								goto l258
+								goto l730
 							}
 							position++
-							goto l249
-						l258:
-							position, tokenIndex = position249, tokenIndex249
+							goto l721
+						l730:
+							position, tokenIndex = position721, tokenIndex721
 							if buffer[position] != rune('{') {
-								goto l259
+								goto l731
 							}
 							position++
-							goto l249
-						l259:
-							position, tokenIndex = position249, tokenIndex249
+							goto l721
+						l731:
+							position, tokenIndex = position721, tokenIndex721
 							if buffer[position] != rune('}') {
-								goto l260
+								goto l732
 							}
 							position++
-							goto l249
-						l260:
-							position, tokenIndex = position249, tokenIndex249
+							goto l721
+						l732:
+							position, tokenIndex = position721, tokenIndex721
 							if buffer[position] != rune(' ') {
-								goto l248
+								goto l720
 							}
 							position++
 						}
-					l249:
-						goto l234
-					l248:
-						position, tokenIndex = position248, tokenIndex248
+					l721:
+						goto l706
+					l720:
+						position, tokenIndex = position720, tokenIndex720
 					}
 					if !matchDot() {
-						goto l234
+						goto l706
 					}
-					goto l233
-				l234:
-					position, tokenIndex = position234, tokenIndex234
+					goto l705
+				l706:
+					position, tokenIndex = position706, tokenIndex706
 				}
-				add(rulestring, position232)
+				add(rulestring, position704)
 			}
 			return true
-		l231:
-			position, tokenIndex = position231, tokenIndex231
+		l703:
+			position, tokenIndex = position703, tokenIndex703
 			return false
 		},
-		/* 32 string_in_quote <- <(!('"' / '\t' / '\r' / '\n') .)+> */
+		/* 65 string_in_quote <- <(!('"' / '\t' / '\r' / '\n') .)+> */
 		func() bool {
-			position261, tokenIndex261 := position, tokenIndex
+			position733, tokenIndex733 := position, tokenIndex
 			{
-				position262 := position
+				position734 := position
 				{
-					position265, tokenIndex265 := position, tokenIndex
+					position737, tokenIndex737 := position, tokenIndex
 					{
-						position266, tokenIndex266 := position, tokenIndex
+						position738, tokenIndex738 := position, tokenIndex
 						if buffer[position] != rune('"') {
-							goto l267
+							goto l739
 						}
 						position++
-						goto l266
-					l267:
-						position, tokenIndex = position266, tokenIndex266
+						goto l738
+					l739:
+						position, tokenIndex = position738, tokenIndex738
 						if buffer[position] != rune('\t') {
-							goto l268
+							goto l740
 						}
 						position++
-						goto l266
-					l268:
-						position, tokenIndex = position266, tokenIndex266
+						goto l738
+					l740:
+						position, tokenIndex = position738, tokenIndex738
 						if buffer[position] != rune('\r') {
-							goto l269
+							goto l741
 						}
 						position++
-						goto l266
-					l269:
-						position, tokenIndex = position266, tokenIndex266
+						goto l738
+					l741:
+						position, tokenIndex = position738, tokenIndex738
 						if buffer[position] != rune('\n') {
-							goto l265
+							goto l737
 						}
 						position++
 					}
-				l266:
-					goto l261
-				l265:
-					position, tokenIndex = position265, tokenIndex265
+				l738:
+					goto l733
+				l737:
+					position, tokenIndex = position737, tokenIndex737
 				}
 				if !matchDot() {
-					goto l261
+					goto l733
 				}
-			l263:
+			l735:
 				{
-					position264, tokenIndex264 := position, tokenIndex
+					position736, tokenIndex736 := position, tokenIndex
 					{
-						position270, tokenIndex270 := position, tokenIndex
+						position742, tokenIndex742 := position, tokenIndex
 						{
-							position271, tokenIndex271 := position, tokenIndex
+							position743, tokenIndex743 := position, tokenIndex
 							if buffer[position] != rune('"') {
-								goto l272
+								goto l744
 							}
 							position++
-							goto l271
-						l272:
-							position, tokenIndex = position271, tokenIndex271
+							goto l743
+						l744:
+							position, tokenIndex = position743, tokenIndex743
 							if buffer[position] != rune('\t') {
-								goto l273
+								goto l745
 							}
 							position++
-							goto l271
-						l273:
-							position, tokenIndex = position271, tokenIndex271
+							goto l743
+						l745:
+							position, tokenIndex = position743, tokenIndex743
 							if buffer[position] != rune('\r') {
-								goto l274
+								goto l746
 							}
 							position++
-							goto l271
-						l274:
-							position, tokenIndex = position271, tokenIndex271
+							goto l743
+						l746:
+							position, tokenIndex = position743, tokenIndex743
 							if buffer[position] != rune('\n') {
-								goto l270
+								goto l742
 							}
 							position++
 						}
-					l271:
-						goto l264
-					l270:
-						position, tokenIndex = position270, tokenIndex270
+					l743:
+						goto l736
+					l742:
+						position, tokenIndex = position742, tokenIndex742
 					}
 					if !matchDot() {
-						goto l264
+						goto l736
 					}
-					goto l263
-				l264:
-					position, tokenIndex = position264, tokenIndex264
+					goto l735
+				l736:
+					position, tokenIndex = position736, tokenIndex736
 				}
-				add(rulestring_in_quote, position262)
+				add(rulestring_in_quote, position734)
 			}
 			return true
-		l261:
-			position, tokenIndex = position261, tokenIndex261
+		l733:
+			position, tokenIndex = position733, tokenIndex733
 			return false
 		},
-		/* 33 cardinality <- <('0' / '1' / '?' / '*' / '+')> */
+		/* 66 cardinality <- <('0' / '1' / '?' / '*' / '+')> */
 		func() bool {
-			position275, tokenIndex275 := position, tokenIndex
+			position747, tokenIndex747 := position, tokenIndex
 			{
-				position276 := position
+				position748 := position
 				{
-					position277, tokenIndex277 := position, tokenIndex
+					position749, tokenIndex749 := position, tokenIndex
 					if buffer[position] != rune('0') {
-						goto l278
+						goto l750
 					}
 					position++
-					goto l277
-				l278:
-					position, tokenIndex = position277, tokenIndex277
+					goto l749
+				l750:
+					position, tokenIndex = position749, tokenIndex749
 					if buffer[position] != rune('1') {
-						goto l279
+						goto l751
 					}
 					position++
-					goto l277
-				l279:
-					position, tokenIndex = position277, tokenIndex277
+					goto l749
+				l751:
+					position, tokenIndex = position749, tokenIndex749
 					if buffer[position] != rune('?') {
-						goto l280
+						goto l752
 					}
 					position++
-					goto l277
-				l280:
-					position, tokenIndex = position277, tokenIndex277
+					goto l749
+				l752:
+					position, tokenIndex = position749, tokenIndex749
 					if buffer[position] != rune('*') {
-						goto l281
+						goto l753
 					}
 					position++
-					goto l277
-				l281:
-					position, tokenIndex = position277, tokenIndex277
+					goto l749
+				l753:
+					position, tokenIndex = position749, tokenIndex749
 					if buffer[position] != rune('+') {
-						goto l275
+						goto l747
 					}
 					position++
 				}
-			l277:
-				add(rulecardinality, position276)
+			l749:
+				add(rulecardinality, position748)
 			}
 			return true
-		l275:
-			position, tokenIndex = position275, tokenIndex275
+		l747:
+			position, tokenIndex = position747, tokenIndex747
 			return false
 		},
 		nil,
-		/* 36 Action0 <- <{p.Err(begin, buffer)}> */
+		/* 69 Action0 <- <{p.Err(begin, buffer)}> */
 		func() bool {
 			{
 				add(ruleAction0, position)
 			}
 			return true
 		},
-		/* 37 Action1 <- <{p.Err(begin, buffer)}> */
+		/* 70 Action1 <- <{p.Err(begin, buffer)}> */
 		func() bool {
 			{
 				add(ruleAction1, position)
 			}
 			return true
 		},
-		/* 38 Action2 <- <{ p.ClearTableAndColumn() }> */
+		/* 71 Action2 <- <{ p.ClearTableAndColumn() }> */
 		func() bool {
 			{
 				add(ruleAction2, position)
 			}
 			return true
 		},
-		/* 39 Action3 <- <{ p.AddColorDefine() }> */
+		/* 72 Action3 <- <{ p.AddColorDefine() }> */
 		func() bool {
 			{
 				add(ruleAction3, position)
 			}
 			return true
 		},
-		/* 40 Action4 <- <{ p.AddTable(text) }> */
+		/* 73 Action4 <- <{ p.AddGroup(text) }> */
 		func() bool {
 			{
 				add(ruleAction4, position)
 			}
 			return true
 		},
-		/* 41 Action5 <- <{ p.AddColumn(text) }> */
+		/* 74 Action5 <- <{ p.AddGroupMember(text) }> */
 		func() bool {
 			{
 				add(ruleAction5, position)
 			}
 			return true
 		},
-		/* 42 Action6 <- <{ p.AddRelation() }> */
+		/* 75 Action6 <- <{ p.AddEnum(text) }> */
 		func() bool {
 			{
 				add(ruleAction6, position)
 			}
 			return true
 		},
-		/* 43 Action7 <- <{ p.SetRelationLeft(text) }> */
+		/* 76 Action7 <- <{ p.AddEnumValue(text) }> */
 		func() bool {
 			{
 				add(ruleAction7, position)
 			}
 			return true
 		},
-		/* 44 Action8 <- <{ p.SetCardinalityLeft(text)}> */
+		/* 77 Action8 <- <{ p.AddNote(text) }> */
 		func() bool {
 			{
 				add(ruleAction8, position)
 			}
 			return true
 		},
-		/* 45 Action9 <- <{ p.SetRelationRight(text) }> */
+		/* 78 Action9 <- <{ p.AddTable(text) }> */
 		func() bool {
 			{
 				add(ruleAction9, position)
 			}
 			return true
 		},
-		/* 46 Action10 <- <{ p.SetCardinalityRight(text)}> */
+		/* 79 Action10 <- <{ p.SetTableParent(text) }> */
 		func() bool {
 			{
 				add(ruleAction10, position)
 			}
 			return true
 		},
-		/* 47 Action11 <- <{ p.AddTitleKeyValue() }> */
+		/* 80 Action11 <- <{ p.AddColumn(text) }> */
 		func() bool {
 			{
 				add(ruleAction11, position)
 			}
 			return true
 		},
-		/* 48 Action12 <- <{ p.AddTableKeyValue() }> */
+		/* 81 Action12 <- <{ p.SetColumnType(text) }> */
 		func() bool {
 			{
 				add(ruleAction12, position)
 			}
 			return true
 		},
-		/* 49 Action13 <- <{ p.AddColumnKeyValue() }> */
+		/* 82 Action13 <- <{ p.SetColumnNotNull(true) }> */
 		func() bool {
 			{
 				add(ruleAction13, position)
 			}
 			return true
 		},
-		/* 50 Action14 <- <{ p.AddRelationKeyValue() }> */
+		/* 83 Action14 <- <{ p.SetColumnNotNull(false) }> */
 		func() bool {
 			{
 				add(ruleAction14, position)
 			}
 			return true
 		},
-		/* 51 Action15 <- <{ p.SetKey(text) }> */
+		/* 84 Action15 <- <{ p.SetColumnUnique() }> */
 		func() bool {
 			{
 				add(ruleAction15, position)
 			}
 			return true
 		},
-		/* 52 Action16 <- <{ p.SetValue(text) }> */
+		/* 85 Action16 <- <{ p.SetColumnDefault(text) }> */
 		func() bool {
 			{
 				add(ruleAction16, position)
 			}
 			return true
 		},
-		/* 53 Action17 <- <{ p.SetValue(text) }> */
+		/* 86 Action17 <- <{ p.SetColumnCheck(text) }> */
 		func() bool {
 			{
 				add(ruleAction17, position)
 			}
 			return true
 		},
+		/* 87 Action18 <- <{ p.AddRelation() }> */
+		func() bool {
+			{
+				add(ruleAction18, position)
+			}
+			return true
+		},
+		/* 88 Action19 <- <{ p.SetRelationLeft(text) }> */
+		func() bool {
+			{
+				add(ruleAction19, position)
+			}
+			return true
+		},
+		/* 89 Action20 <- <{ p.SetCardinalityLeft(text)}> */
+		func() bool {
+			{
+				add(ruleAction20, position)
+			}
+			return true
+		},
+		/* 90 Action21 <- <{ p.SetRelationRight(text) }> */
+		func() bool {
+			{
+				add(ruleAction21, position)
+			}
+			return true
+		},
+		/* 91 Action22 <- <{ p.SetCardinalityRight(text)}> */
+		func() bool {
+			{
+				add(ruleAction22, position)
+			}
+			return true
+		},
+		/* 92 Action23 <- <{ p.SetRelationOperator(text) }> */
+		func() bool {
+			{
+				add(ruleAction23, position)
+			}
+			return true
+		},
+		/* 93 Action24 <- <{ p.AddRelationship(text) }> */
+		func() bool {
+			{
+				add(ruleAction24, position)
+			}
+			return true
+		},
+		/* 94 Action25 <- <{ p.SetParticipant(text) }> */
+		func() bool {
+			{
+				add(ruleAction25, position)
+			}
+			return true
+		},
+		/* 95 Action26 <- <{ p.AddParticipant(text) }> */
+		func() bool {
+			{
+				add(ruleAction26, position)
+			}
+			return true
+		},
+		/* 96 Action27 <- <{ p.AddTitleKeyValue() }> */
+		func() bool {
+			{
+				add(ruleAction27, position)
+			}
+			return true
+		},
+		/* 97 Action28 <- <{ p.AddGraphKeyValue() }> */
+		func() bool {
+			{
+				add(ruleAction28, position)
+			}
+			return true
+		},
+		/* 98 Action29 <- <{ p.AddTableKeyValue() }> */
+		func() bool {
+			{
+				add(ruleAction29, position)
+			}
+			return true
+		},
+		/* 99 Action30 <- <{ p.AddColumnKeyValue() }> */
+		func() bool {
+			{
+				add(ruleAction30, position)
+			}
+			return true
+		},
+		/* 100 Action31 <- <{ p.AddGroupKeyValue() }> */
+		func() bool {
+			{
+				add(ruleAction31, position)
+			}
+			return true
+		},
+		/* 101 Action32 <- <{ p.AddRelationKeyValue() }> */
+		func() bool {
+			{
+				add(ruleAction32, position)
+			}
+			return true
+		},
+		/* 102 Action33 <- <{ p.AddNoteKeyValue() }> */
+		func() bool {
+			{
+				add(ruleAction33, position)
+			}
+			return true
+		},
+		/* 103 Action34 <- <{ p.AddRelationshipKeyValue() }> */
+		func() bool {
+			{
+				add(ruleAction34, position)
+			}
+			return true
+		},
+		/* 104 Action35 <- <{ p.SetKey(text) }> */
+		func() bool {
+			{
+				add(ruleAction35, position)
+			}
+			return true
+		},
+		/* 105 Action36 <- <{ p.SetValue(text) }> */
+		func() bool {
+			{
+				add(ruleAction36, position)
+			}
+			return true
+		},
+		/* 106 Action37 <- <{ p.SetValue(text) }> */
+		func() bool {
+			{
+				add(ruleAction37, position)
+			}
+			return true
+		},
 	}
 	p.rules = _rules
 	return nil
@@ -0,0 +1,240 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// Filter selects the part of an ERD to render
+type Filter struct {
+	Focus   []string // tables to center the diagram on
+	Depth   int      // number of relation hops kept around the focus tables
+	Include []string // glob patterns a table name must match
+	Exclude []string // glob patterns a table name must not match
+	Stubs   bool     // keep relations leaving the selection, pointing to collapsed nodes
+}
+
+// Stub is a collapsed node standing in for a table outside the selection
+type Stub struct {
+	Name  string
+	Title string
+}
+
+func matchAny(patterns []string, t *Table) bool {
+	for _, pattern := range patterns {
+		if ok, _ := filepath.Match(pattern, t.Title); ok {
+			return true
+		}
+		if ok, _ := filepath.Match(pattern, t.Name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// lookupTable finds a table by its title or name
+func (e *Erd) lookupTable(text string) (*Table, bool) {
+	if table, ok := e.Tables[replaceAllIllegal(text)]; ok {
+		return table, true
+	}
+	for _, table := range e.Tables {
+		if table.Title == text {
+			return table, true
+		}
+	}
+	return nil, false
+}
+
+// neighbourhood returns the tables within depth relation hops of the given tables
+func (e *Erd) neighbourhood(names []string, depth int) map[string]bool {
+	found := map[string]bool{}
+	for _, name := range names {
+		found[name] = true
+	}
+
+	frontier := names
+	for i := 0; i < depth && len(frontier) > 0; i++ {
+		var next []string
+		for _, name := range frontier {
+			for _, r := range e.Relations {
+				other := ""
+				switch name {
+				case r.LeftTableName:
+					other = r.RightTableName
+				case r.RightTableName:
+					other = r.LeftTableName
+				}
+				if other != "" && !found[other] {
+					found[other] = true
+					next = append(next, other)
+				}
+			}
+			for _, t := range e.Tables {
+				other := ""
+				switch name {
+				case t.Name:
+					other = t.Extends
+				case t.Extends:
+					other = t.Name
+				}
+				if _, ok := e.Tables[other]; ok && !found[other] {
+					found[other] = true
+					next = append(next, other)
+				}
+			}
+			for _, r := range e.Relationships {
+				if !r.has(name) {
+					continue
+				}
+				for _, p := range r.Participants {
+					if !found[p.TableName] {
+						found[p.TableName] = true
+						next = append(next, p.TableName)
+					}
+				}
+			}
+		}
+		frontier = next
+	}
+	return found
+}
+
+// Filter returns a copy of the ERD holding only the tables selected by f,
+// all of them for an empty filter
+func (e *Erd) Filter(f Filter) (*Erd, error) {
+	var keep map[string]bool
+	if len(f.Focus) > 0 {
+		var names []string
+		for _, text := range f.Focus {
+			table, ok := e.lookupTable(text)
+			if !ok {
+				return nil, fmt.Errorf("unknown table %q", text)
+			}
+			names = append(names, table.Name)
+		}
+		keep = e.neighbourhood(names, f.Depth)
+	}
+
+	out := *e
+	out.Tables = map[string]*Table{}
+	out.TableNames = nil
+	out.Relations = nil
+	out.Groups = nil
+	out.Isolations = nil
+	out.Stubs = nil
+	for _, name := range e.TableNames {
+		table, ok := e.Tables[name]
+		if !ok || (keep != nil && !keep[name]) {
+			continue
+		}
+		if len(f.Include) > 0 && !matchAny(f.Include, table) {
+			continue
+		}
+		if matchAny(f.Exclude, table) {
+			continue
+		}
+		t := *table
+		t.Connected = false
+		out.Tables[name] = &t
+		out.TableNames = append(out.TableNames, name)
+	}
+
+	stubs := map[string]bool{}
+	stub := func(name string) string {
+		id := name + "__stub"
+		if !stubs[id] {
+			stubs[id] = true
+			title := name
+			if table, ok := e.Tables[name]; ok {
+				title = table.Title
+			}
+			out.Stubs = append(out.Stubs, Stub{Name: id, Title: title})
+		}
+		return id
+	}
+	for _, name := range out.TableNames {
+		t := out.Tables[name]
+		if _, ok := out.Tables[t.Extends]; ok || t.Extends == "" {
+			continue
+		}
+		if f.Stubs {
+			t.Extends = stub(t.Extends)
+			out.Connect(name)
+		} else {
+			t.Extends = ""
+		}
+	}
+	for _, r := range e.Relations {
+		_, left := out.Tables[r.LeftTableName]
+		_, right := out.Tables[r.RightTableName]
+		switch {
+		case left && right:
+		case left && f.Stubs:
+			r.RightTableName = stub(r.RightTableName)
+		case right && f.Stubs:
+			r.LeftTableName = stub(r.LeftTableName)
+		default:
+			continue
+		}
+		out.Relations = append(out.Relations, r)
+		out.Connect(r.LeftTableName)
+		out.Connect(r.RightTableName)
+	}
+
+	// relationships are kept when all their tables are
+	out.Relationships = nil
+	for _, r := range e.Relationships {
+		kept := true
+		for _, p := range r.Participants {
+			_, ok := out.Tables[p.TableName]
+			kept = kept && ok
+		}
+		if kept {
+			out.Relationships = append(out.Relationships, r)
+			for _, p := range r.Participants {
+				out.Connect(p.TableName)
+			}
+		}
+	}
+
+	// enums are kept when a column of the tables uses them
+	out.Enums = nil
+	for _, en := range e.Enums {
+		used := false
+		for _, t := range out.Tables {
+			for _, c := range t.Columns {
+				used = used || e.EnumOf(c) == en
+			}
+		}
+		if used {
+			out.Enums = append(out.Enums, en)
+		}
+	}
+
+	// notes are kept when their table is, or when they are not attached
+	out.Notes = nil
+	for _, n := range e.Notes {
+		kept := n.NoteAttributes["attach"] == ""
+		if t, _ := e.attachment(n); t != nil {
+			_, kept = out.Tables[t.Name]
+		}
+		if kept {
+			out.Notes = append(out.Notes, n)
+		}
+	}
+
+	for _, g := range e.Groups {
+		var names []string
+		for _, name := range g.TableNames {
+			if _, ok := out.Tables[name]; ok {
+				names = append(names, name)
+			}
+		}
+		if len(names) > 0 {
+			og := *g
+			og.TableNames = names
+			out.Groups = append(out.Groups, &og)
+		}
+	}
+	return &out, nil
+}
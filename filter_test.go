@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+const filterSchema = `
+[a]
+*id
+
+[b]
+*id
+
+[c]
+*id
+state {type: status}
+
+[d]
+*id
+
+[e] extends d
+*id
+
+a 1--* b
+b 1--* c
+c 1--* d
+
+note "about a" {attach: a}
+note "about c" {attach: c.id}
+note "free floating"
+
+enum status {on, off}
+`
+
+func TestErd_Filter(t *testing.T) {
+	erd, err := parseErd(filterSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		filter Filter
+		tables string
+		stubs  int
+		notes  int
+		enums  int
+	}{
+		{Filter{Focus: []string{"b"}, Depth: 0}, "b", 0, 1, 0},
+		{Filter{Focus: []string{"b"}, Depth: 1}, "a,b,c", 0, 3, 1},
+		{Filter{Focus: []string{"b"}, Depth: 1, Stubs: true}, "a,b,c", 1, 3, 1},
+		{Filter{Focus: []string{"a"}, Depth: 2, Exclude: []string{"b"}}, "a,c", 0, 3, 1},
+		{Filter{Include: []string{"[bc]"}}, "b,c", 0, 2, 1},
+		{Filter{Focus: []string{"e"}, Depth: 1}, "d,e", 0, 1, 0},
+		{Filter{Focus: []string{"e"}, Depth: 0, Stubs: true}, "e", 1, 1, 0},
+		{Filter{}, "a,b,c,d,e", 0, 3, 1},
+	}
+	for _, tt := range tests {
+		got, err := erd.Filter(tt.filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got == erd {
+			t.Errorf("%+v: got the ERD itself, want a copy", tt.filter)
+		}
+		if tables := strings.Join(got.TableNames, ","); tables != tt.tables {
+			t.Errorf("%+v: got: %v\nwant: %v", tt.filter, tables, tt.tables)
+		}
+		if len(got.Stubs) != tt.stubs {
+			t.Errorf("%+v: got: %v stubs\nwant: %v", tt.filter, len(got.Stubs), tt.stubs)
+		}
+		if len(got.Notes) != tt.notes {
+			t.Errorf("%+v: got: %v notes\nwant: %v", tt.filter, len(got.Notes), tt.notes)
+		}
+		if len(got.Enums) != tt.enums {
+			t.Errorf("%+v: got: %v enums\nwant: %v", tt.filter, len(got.Enums), tt.enums)
+		}
+	}
+
+	if _, err := erd.Filter(Filter{Focus: []string{"x"}}); err == nil {
+		t.Errorf("unknown focus table should be reported")
+	}
+}
+
+func TestStubs(t *testing.T) {
+	erd, err := parseErd(filterSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	erd, err = erd.Filter(Filter{Focus: []string{"b"}, Stubs: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		theme *Theme
+		want  string
+	}{
+		{nil, `a__stub [label="…",tooltip="a",shape=box,style="rounded,dashed",color="grey60",fontcolor="grey60"];`},
+		{&Theme{}, `a__stub [label="…",tooltip="a",shape=box,style="rounded,dashed"];`},
+	}
+	for _, tt := range tests {
+		erd.theme = tt.theme
+		var buf bytes.Buffer
+		if err := loadTemplates("").ExecuteTemplate(&buf, "dot", erd); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(buf.String(), tt.want) {
+			t.Errorf("%q not found in\n%v", tt.want, buf.String())
+		}
+	}
+}
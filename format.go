@@ -0,0 +1,424 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"unicode/utf8"
+
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// FmtCommand rewrites .er files in the canonical layout
+type FmtCommand struct {
+	Write bool `short:"w" long:"write" description:"write the result to the source file instead of stdout."`
+	Diff  bool `short:"d" long:"diff" description:"display diffs instead of the formatted source."`
+	Check bool `long:"check" description:"list the files which are not formatted and exit with status 1."`
+	Sort  bool `short:"s" long:"sort" description:"sort tables by name and relations within each block."`
+}
+
+var fmtCommand FmtCommand
+
+// Execute formats the given files, or stdin when there are none
+func (c *FmtCommand) Execute(args []string) error {
+	if len(args) == 0 {
+		if c.Write {
+			return fmt.Errorf("cannot use -w with stdin")
+		}
+		if terminal.IsTerminal(int(syscall.Stdin)) {
+			return fmt.Errorf("no input files")
+		}
+		body, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+		_, err = c.format("<stdin>", string(body))
+		return err
+	}
+
+	inputs, err := readInputs(args)
+	if err != nil {
+		return err
+	}
+	unformatted := false
+	for _, in := range inputs {
+		changed, err := c.format(in.Name, in.Contents)
+		if err != nil {
+			return err
+		}
+		unformatted = unformatted || changed
+	}
+	if c.Check && unformatted {
+		return exitStatus(1)
+	}
+	return nil
+}
+
+// format formats a single source and reports whether it was changed
+func (c *FmtCommand) format(name, source string) (bool, error) {
+	f, err := ParseSyntax(source)
+	if err != nil {
+		return false, fmt.Errorf("%s:%v", name, err)
+	}
+	out := Format(f, c.Sort)
+	changed := out != source
+
+	switch {
+	case c.Check:
+		if changed {
+			fmt.Println(name)
+		}
+	case c.Diff:
+		fmt.Print(unifiedDiff(name+".orig", name, source, out))
+	case c.Write:
+		if changed {
+			info, err := os.Stat(name)
+			if err != nil {
+				return changed, err
+			}
+			return changed, ioutil.WriteFile(name, []byte(out), info.Mode())
+		}
+	default:
+		fmt.Print(out)
+	}
+	return changed, nil
+}
+
+// Format prints the syntax tree in the canonical layout.
+// With sortTables, tables are ordered by name and relations are sorted
+// within each block of consecutive relations.
+func Format(f *SyntaxFile, sortTables bool) string {
+	items := f.Statements
+	if sortTables {
+		items = sortStatements(items)
+	}
+
+	p := &printer{f: f}
+	var prev *SyntaxNode
+	blank := false
+	for i := 0; i < len(items); i++ {
+		n := items[i]
+		if n.Kind == SyntaxBlank {
+			blank = prev != nil
+			continue
+		}
+		if prev != nil && (blank || needsBlank(prev, n)) {
+			p.WriteString("\n")
+		}
+		blank = false
+
+		if n.Kind == SyntaxRelation {
+			j := i
+			for j < len(items) && items[j].Kind == SyntaxRelation {
+				j++
+			}
+			p.relations(items[i:j])
+			i = j - 1
+			n = items[i]
+		} else {
+			blank = p.statement(n)
+		}
+		prev = n
+	}
+	return p.String()
+}
+
+// needsBlank reports whether a blank line is required between two statements
+func needsBlank(prev, next *SyntaxNode) bool {
+	if prev.Kind == SyntaxComment {
+		return false
+	}
+	block := func(n *SyntaxNode) bool {
+		switch n.Kind {
+		case SyntaxTitle, SyntaxGraph, SyntaxColors, SyntaxGroup, SyntaxEnum, SyntaxTable, SyntaxRelationship:
+			return true
+		}
+		return false
+	}
+	return block(prev) || block(next)
+}
+
+// sortStatements orders the tables by name, keeping the comments right above
+// a table with it, and sorts each block of consecutive relations
+func sortStatements(items []*SyntaxNode) []*SyntaxNode {
+	type chunk struct {
+		start int
+		nodes []*SyntaxNode
+	}
+	var chunks []chunk
+	for i, n := range items {
+		if n.Kind != SyntaxTable {
+			continue
+		}
+		start := i
+		for start > 0 && items[start-1].Kind == SyntaxComment {
+			start--
+		}
+		chunks = append(chunks, chunk{start: start, nodes: items[start : i+1]})
+	}
+	sorted := append([]chunk(nil), chunks...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a := sorted[i].nodes[len(sorted[i].nodes)-1].Child(SyntaxName).Text
+		b := sorted[j].nodes[len(sorted[j].nodes)-1].Child(SyntaxName).Text
+		return strings.ToLower(a) < strings.ToLower(b)
+	})
+
+	var out []*SyntaxNode
+	for i, k := 0, 0; i < len(items); {
+		if k < len(chunks) && chunks[k].start == i {
+			out = append(out, sorted[k].nodes...)
+			i += len(chunks[k].nodes)
+			k++
+			continue
+		}
+		out = append(out, items[i])
+		i++
+	}
+
+	for i := 0; i < len(out); {
+		j := i
+		for j < len(out) && out[j].Kind == SyntaxRelation {
+			j++
+		}
+		if j > i {
+			run := out[i:j]
+			sort.SliceStable(run, func(a, b int) bool {
+				ra, rb := run[a].ChildrenOf(SyntaxReference), run[b].ChildrenOf(SyntaxReference)
+				if ra[0].Text != rb[0].Text {
+					return ra[0].Text < rb[0].Text
+				}
+				return ra[1].Text < rb[1].Text
+			})
+			i = j
+			continue
+		}
+		i++
+	}
+	return out
+}
+
+type printer struct {
+	strings.Builder
+	f *SyntaxFile
+}
+
+func pad(s string, width int) string {
+	return s + strings.Repeat(" ", width-utf8.RuneCountInString(s))
+}
+
+// value prints an attribute value, always quoted
+func (p *printer) value(n *SyntaxNode) string {
+	raw := p.f.Raw(n)
+	if strings.HasPrefix(raw, `"`) {
+		return raw
+	}
+	return strconv.Quote(n.Text)
+}
+
+func (p *printer) attribute(n *SyntaxNode) string {
+	return p.f.Raw(n.Child(SyntaxKey)) + ": " + p.value(n.Child(SyntaxValue))
+}
+
+// attributes prints the attributes of a node on one line
+func (p *printer) attributes(n *SyntaxNode) string {
+	var attrs []string
+	for _, a := range n.ChildrenOf(SyntaxAttribute) {
+		attrs = append(attrs, p.attribute(a))
+	}
+	if len(attrs) == 0 {
+		return ""
+	}
+	return "{" + strings.Join(attrs, ", ") + "}"
+}
+
+// statement prints a statement and reports whether it ended with blank lines
+func (p *printer) statement(n *SyntaxNode) bool {
+	switch n.Kind {
+	case SyntaxComment:
+		p.WriteString(strings.TrimRight("#"+n.Text, " \t") + "\n")
+	case SyntaxTitle:
+		attrs := p.attributes(n)
+		if attrs == "" {
+			attrs = "{}"
+		}
+		p.WriteString("title " + attrs + "\n")
+	case SyntaxGraph:
+		p.block("graph", n)
+	case SyntaxColors:
+		p.block("colors", n)
+	case SyntaxGroup:
+		p.group(n)
+	case SyntaxEnum:
+		p.enum(n)
+	case SyntaxNote:
+		p.note(n)
+	case SyntaxTable:
+		return p.table(n)
+	case SyntaxRelationship:
+		return p.relationship(n)
+	}
+	return false
+}
+
+// block prints the attributes of a graph or colors block, one per line
+func (p *printer) block(keyword string, n *SyntaxNode) {
+	attrs := n.ChildrenOf(SyntaxAttribute)
+	if len(attrs) == 0 {
+		p.WriteString(keyword + " {}\n")
+		return
+	}
+	width := 0
+	for _, a := range attrs {
+		if w := utf8.RuneCountInString(p.f.Raw(a.Child(SyntaxKey))); w > width {
+			width = w
+		}
+	}
+	p.WriteString(keyword + " {\n")
+	for _, a := range attrs {
+		key := p.f.Raw(a.Child(SyntaxKey)) + ":"
+		p.WriteString("    " + pad(key, width+1) + " " + p.value(a.Child(SyntaxValue)) + ",\n")
+	}
+	p.WriteString("}\n")
+}
+
+func (p *printer) group(n *SyntaxNode) {
+	p.WriteString("group " + strconv.Quote(n.Child(SyntaxName).Text))
+	if attrs := p.attributes(n); attrs != "" {
+		p.WriteString(" " + attrs)
+	}
+	var members []string
+	for _, m := range n.ChildrenOf(SyntaxReference) {
+		members = append(members, m.Text)
+	}
+	p.WriteString(" {" + strings.Join(members, ", ") + "}\n")
+}
+
+// enum prints an enum and its values on one line
+func (p *printer) enum(n *SyntaxNode) {
+	var values []string
+	for _, v := range n.ChildrenOf(SyntaxValue) {
+		values = append(values, p.f.Raw(v))
+	}
+	p.WriteString("enum " + n.Child(SyntaxName).Text + " {" + strings.Join(values, ", ") + "}\n")
+}
+
+// note prints a note and its attributes on one line
+func (p *printer) note(n *SyntaxNode) {
+	p.WriteString("note " + p.f.Raw(n.Child(SyntaxValue)))
+	if attrs := p.attributes(n); attrs != "" {
+		p.WriteString(" " + attrs)
+	}
+	p.WriteString("\n")
+}
+
+// table prints a table and its columns
+func (p *printer) table(n *SyntaxNode) bool {
+	p.WriteString("[" + n.Child(SyntaxName).Text + "]")
+	if parent := n.Child(SyntaxReference); parent != nil {
+		p.WriteString(" extends " + parent.Text)
+	}
+	if attrs := p.attributes(n); attrs != "" {
+		p.WriteString(" " + attrs)
+	}
+	p.WriteString("\n")
+	return p.columns(n)
+}
+
+// relationship prints a relationship, its participants on one line, and its
+// columns like those of a table
+func (p *printer) relationship(n *SyntaxNode) bool {
+	var participants []string
+	cards := n.ChildrenOf(SyntaxCardinality)
+	for i, ref := range n.ChildrenOf(SyntaxReference) {
+		participants = append(participants, ref.Text+" "+cards[i].Text)
+	}
+	p.WriteString("<" + n.Child(SyntaxName).Text + "> " + strings.Join(participants, ", "))
+	if attrs := p.attributes(n); attrs != "" {
+		p.WriteString(" " + attrs)
+	}
+	p.WriteString("\n")
+	return p.columns(n)
+}
+
+// definition prints the type and constraints of a column
+func (p *printer) definition(c *SyntaxNode) string {
+	var parts []string
+	for _, n := range c.Children {
+		if n.Kind == SyntaxType || n.Kind == SyntaxConstraint {
+			parts = append(parts, n.Text)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// columns prints the columns of a table or relationship, their definitions
+// and attributes aligned, and reports whether they ended with blank lines
+func (p *printer) columns(n *SyntaxNode) bool {
+	nameWidth, defWidth := 0, 0
+	for _, c := range n.ChildrenOf(SyntaxColumn) {
+		def, attrs := p.definition(c), p.attributes(c)
+		if w := utf8.RuneCountInString(c.Child(SyntaxName).Text); w > nameWidth && (def != "" || attrs != "") {
+			nameWidth = w
+		}
+		if w := utf8.RuneCountInString(def); w > defWidth && attrs != "" {
+			defWidth = w
+		}
+	}
+
+	blank := false
+	for _, c := range n.Children {
+		switch c.Kind {
+		case SyntaxBlank:
+			blank = true
+		case SyntaxColumn:
+			if blank {
+				p.WriteString("\n")
+				blank = false
+			}
+			line := c.Child(SyntaxName).Text
+			def, attrs := p.definition(c), p.attributes(c)
+			if def != "" || attrs != "" {
+				line = pad(line, nameWidth)
+			}
+			if attrs != "" && defWidth > 0 {
+				line += " " + pad(def, defWidth)
+			} else if def != "" {
+				line += " " + def
+			}
+			if attrs != "" {
+				line += " " + attrs
+			}
+			p.WriteString("  " + line + "\n")
+		}
+	}
+	return blank
+}
+
+// relations prints a block of consecutive relations, aligning their columns
+func (p *printer) relations(items []*SyntaxNode) {
+	left, right := 0, 0
+	for _, n := range items {
+		refs := n.ChildrenOf(SyntaxReference)
+		if w := utf8.RuneCountInString(refs[0].Text); w > left {
+			left = w
+		}
+		if w := utf8.RuneCountInString(refs[1].Text); w > right && p.attributes(n) != "" {
+			right = w
+		}
+	}
+	for _, n := range items {
+		refs := n.ChildrenOf(SyntaxReference)
+		cards := n.ChildrenOf(SyntaxCardinality)
+		line := pad(refs[0].Text, left) + " " + cards[0].Text + n.Child(SyntaxOperator).Text + cards[1].Text + " "
+		if attrs := p.attributes(n); attrs != "" {
+			line += pad(refs[1].Text, right) + " " + attrs
+		} else {
+			line += refs[1].Text
+		}
+		p.WriteString(line + "\n")
+	}
+}
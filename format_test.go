@@ -0,0 +1,157 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		sort   bool
+		want   string
+	}{
+		{
+			name: "tables and columns",
+			source: `title {label:x,size: "20"}
+# entities
+[b] {bgcolor:"#fff"}
+*id {label: "int"}
+  name_long {label:varchar}
+  other
+
+
+[a]
+x
+`,
+			want: `title {label: "x", size: "20"}
+
+# entities
+[b] {bgcolor: "#fff"}
+  *id       {label: "int"}
+  name_long {label: "varchar"}
+  other
+
+[a]
+  x
+`,
+		},
+		{
+			name: "relations and colors",
+			source: `colors {a: "#fff", long_name: "#000"}
+a 1--* b
+long *--? c {label: x}
+`,
+			want: `colors {
+    a:         "#fff",
+    long_name: "#000",
+}
+
+a    1--* b
+long *--? c {label: "x"}
+`,
+		},
+		{
+			name: "graph",
+			source: `graph {rankdir:TB,node.fontname: "Helvetica"}
+[a]
+`,
+			want: `graph {
+    rankdir:       "TB",
+    node.fontname: "Helvetica",
+}
+
+[a]
+`,
+		},
+		{
+			name:   "identifying relations",
+			source: "game 1==* drive\ndrive 1--* play {identifying:true}\n",
+			want:   "game  1==* drive\ndrive 1--* play {identifying: \"true\"}\n",
+		},
+		{
+			name: "relationships",
+			source: `<enrollment>Student *,Course *,  Term 1 {label:enrolls}
+grade {label: "char(2)"}
+`,
+			want: `<enrollment> Student *, Course *, Term 1 {label: "enrolls"}
+  grade {label: "char(2)"}
+`,
+		},
+		{
+			name:   "subtypes",
+			source: "[Person]   extends Party{disjoint:true}\nname\n",
+			want:   "[Person] extends Party {disjoint: \"true\"}\n  name\n",
+		},
+		{
+			name:   "enums",
+			source: "enum  pos{QB,RB,\n  \"not applicable\" }\n[a]\nposition {type: pos}\n",
+			want:   "enum pos {QB, RB, \"not applicable\"}\n\n[a]\n  position {type: \"pos\"}\n",
+		},
+		{
+			name:   "notes",
+			source: "[a]\nid\nnote   \"about\\na\"{attach:a.id}\nnote \"free\"\n",
+			want:   "[a]\n  id\n\nnote \"about\\na\" {attach: \"a.id\"}\nnote \"free\"\n",
+		},
+		{
+			name:   "column definitions",
+			source: "[a]\nid   INT  not NULL default 0 {label: x}\nname varchar( 20 ) check(name <> '')\nnote {label: y}\n",
+			want:   "[a]\n  id   INT not null default 0 {label: \"x\"}\n  name varchar( 20 ) check (name <> '')\n  note                        {label: \"y\"}\n",
+		},
+		{
+			name: "sort",
+			sort: true,
+			source: `[b]
+id
+
+# about a
+[a]
+id
+
+b 1--* a
+a 1--* b
+`,
+			want: `# about a
+[a]
+  id
+
+[b]
+  id
+
+a 1--* b
+b 1--* a
+`,
+		},
+	}
+	for _, tt := range tests {
+		f, err := ParseSyntax(tt.source)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		got := Format(f, tt.sort)
+		if got != tt.want {
+			t.Errorf("%s:\ngot:\n%v\nwant:\n%v", tt.name, got, tt.want)
+		}
+
+		// formatting is idempotent
+		f, err = ParseSyntax(got)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if again := Format(f, tt.sort); again != got {
+			t.Errorf("%s: not idempotent:\n%v", tt.name, unifiedDiff("got", "again", got, again))
+		}
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	got := unifiedDiff("a", "b", "x\ny\nz\n", "x\nY\nz\n")
+	want := "--- a\n+++ b\n@@ -1,3 +1,3 @@\n x\n-y\n+Y\n z\n"
+	if got != want {
+		t.Errorf("got:\n%v\nwant:\n%v", got, want)
+	}
+	if !strings.Contains(unifiedDiff("a", "b", "x", "y"), "No newline") {
+		t.Errorf("missing newline at end of file not reported")
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// templateFuncs are the functions available to the templates, the built-in
+// ones of text/template aside:
+//
+//	StringsJoin LIST SEP  joins the strings of LIST with SEP (also join)
+//	split S SEP           splits S around SEP
+//	lower S, upper S      changes the case of S
+//	trim S                removes the surrounding whitespace of S
+//	replace S OLD NEW     replaces every OLD in S with NEW
+//	contains S SUB        reports whether SUB is within S
+//	hasPrefix S PREFIX    reports whether S starts with PREFIX
+//	hasSuffix S SUFFIX    reports whether S ends with SUFFIX
+//	quote S               quotes S like a Go string, fit for DOT attributes
+//	default DEF V         returns V, or DEF when V is empty
+//	add A B, sub A B      adds or subtracts two integers
+//	sortedKeys MAP        returns the keys of an attribute map, sorted
+//	columnName COLUMN     returns the name of a column without its key markers
+//	isPrimaryKey COLUMN   reports whether the column is a primary key (*)
+//	isForeignKey COLUMN   reports whether the column is a foreign key (+)
+var templateFuncs = template.FuncMap{
+	"StringsJoin": strings.Join,
+	"join":        strings.Join,
+	"split":       strings.Split,
+	"lower":       strings.ToLower,
+	"upper":       strings.ToUpper,
+	"trim":        strings.TrimSpace,
+	"replace": func(s, old, new string) string {
+		return strings.Replace(s, old, new, -1)
+	},
+	"contains":  strings.Contains,
+	"hasPrefix": strings.HasPrefix,
+	"hasSuffix": strings.HasSuffix,
+	"quote":     strconv.Quote,
+	"default": func(def, v interface{}) interface{} {
+		if v == nil {
+			return def
+		}
+		if rv := reflect.ValueOf(v); rv.IsZero() {
+			return def
+		}
+		return v
+	},
+	"add": func(a, b int) int { return a + b },
+	"sub": func(a, b int) int { return a - b },
+	"sortedKeys": func(m map[string]string) []string {
+		var keys []string
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		return keys
+	},
+	"columnName": func(c Column) string {
+		return columnName(c.Title)
+	},
+	"isPrimaryKey": func(c Column) bool {
+		return strings.Contains(columnKey(c.Title), "primary")
+	},
+	"isForeignKey": func(c Column) bool {
+		return strings.Contains(columnKey(c.Title), "foreign")
+	},
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTemplates(t *testing.T) {
+	dir, err := ioutil.TempDir("", "erd-templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	files := map[string]string{
+		"dot_tables.tmpl": `{{define "dot_tables"}}{{range .TableNames}}[{{.}}]{{end}}{{end}}`,
+		"keys.tmpl":       `{{range (index $.Tables "a").Columns}}{{if isPrimaryKey .}}{{columnName . | upper}}{{end}}{{end}}`,
+		"dot.tmpl":        `{{template "dot_tables" .}} {{template "keys" .}} {{default "untitled" .Title.TitleAttributes.label}} {{add 1 2}}`,
+	}
+	for name, body := range files {
+		err = ioutil.WriteFile(filepath.Join(dir, name), []byte(body), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, "relations.txt"), []byte(`{{define "dot_tables"}}relations{{end}}`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	erd, err := parseErd("[a]\n*id\nname\n[b]\n*id\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		dir       string
+		templates []string
+		want      string
+	}{
+		{dir, nil, "[a][b] ID untitled 3"},
+		{dir, []string{"dot_tables=" + filepath.Join(dir, "relations.txt")}, "relations ID untitled 3"},
+	}
+	for _, tt := range tests {
+		tmpl, err := templates("", tt.dir, tt.templates)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		err = tmpl.ExecuteTemplate(&buf, "dot", erd)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != tt.want {
+			t.Errorf("got: %q\nwant: %q", buf.String(), tt.want)
+		}
+	}
+
+	if _, err := templates("", "", []string{"dot"}); err == nil {
+		t.Errorf("got: no error\nwant: an error for --template without a file")
+	}
+}
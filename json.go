@@ -0,0 +1,172 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// jsonColumn is a column in the JSON export
+type jsonColumn struct {
+	Name       string            `json:"name"`
+	PrimaryKey bool              `json:"primary_key,omitempty"`
+	ForeignKey bool              `json:"foreign_key,omitempty"`
+	Type       string            `json:"type,omitempty"`
+	NotNull    bool              `json:"not_null,omitempty"`
+	Default    string            `json:"default,omitempty"`
+	Unique     bool              `json:"unique,omitempty"`
+	Check      string            `json:"check,omitempty"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// jsonTable is a table in the JSON export
+type jsonTable struct {
+	Name       string            `json:"name"`
+	Extends    string            `json:"extends,omitempty"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	Columns    []jsonColumn      `json:"columns"`
+}
+
+// jsonRelation is a relation in the JSON export
+type jsonRelation struct {
+	Left             string            `json:"left"`
+	LeftCardinality  string            `json:"left_cardinality"`
+	Right            string            `json:"right"`
+	RightCardinality string            `json:"right_cardinality"`
+	Identifying      bool              `json:"identifying,omitempty"`
+	Attributes       map[string]string `json:"attributes,omitempty"`
+}
+
+// jsonParticipant is a table taking part in a relationship
+type jsonParticipant struct {
+	Table       string `json:"table"`
+	Cardinality string `json:"cardinality"`
+}
+
+// jsonRelationship is a relationship in the JSON export
+type jsonRelationship struct {
+	Name         string            `json:"name"`
+	Participants []jsonParticipant `json:"participants"`
+	Attributes   map[string]string `json:"attributes,omitempty"`
+	Columns      []jsonColumn      `json:"columns,omitempty"`
+}
+
+// jsonEnum is an enum in the JSON export
+type jsonEnum struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+// jsonNote is a note statement in the JSON export
+type jsonNote struct {
+	Text       string            `json:"text"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// jsonGroup is a group in the JSON export
+type jsonGroup struct {
+	Name       string            `json:"name"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	Tables     []string          `json:"tables"`
+}
+
+// jsonErd is the JSON export of an ERD. Tables are named by their title and
+// listed in the order of the source.
+type jsonErd struct {
+	Title         map[string]string  `json:"title,omitempty"`
+	Graph         map[string]string  `json:"graph,omitempty"`
+	Tables        []jsonTable        `json:"tables"`
+	Relations     []jsonRelation     `json:"relations"`
+	Relationships []jsonRelationship `json:"relationships,omitempty"`
+	Enums         []jsonEnum         `json:"enums,omitempty"`
+	Notes         []jsonNote         `json:"notes,omitempty"`
+	Groups        []jsonGroup        `json:"groups,omitempty"`
+}
+
+// nonEmpty returns nil for empty attributes, so they are left out
+func nonEmpty(attrs map[string]string) map[string]string {
+	if len(attrs) == 0 {
+		return nil
+	}
+	return attrs
+}
+
+// columns exports the columns of a table or relationship
+func columns(cs []Column) []jsonColumn {
+	var out []jsonColumn
+	for _, c := range cs {
+		out = append(out, jsonColumn{
+			Name:       strings.TrimLeft(c.Title, "*+"),
+			PrimaryKey: strings.HasPrefix(strings.TrimPrefix(c.Title, "+"), "*"),
+			ForeignKey: strings.HasPrefix(strings.TrimPrefix(c.Title, "*"), "+"),
+			Type:       c.Type,
+			NotNull:    c.NotNull,
+			Default:    c.Default,
+			Unique:     c.Unique,
+			Check:      c.Check,
+			Attributes: nonEmpty(c.ColumnAttributes),
+		})
+	}
+	return out
+}
+
+// MarshalJSON exports the model of the ERD without the parser state
+func (e *Erd) MarshalJSON() ([]byte, error) {
+	title := func(name string) string {
+		if t, ok := e.Tables[name]; ok {
+			return t.Title
+		}
+		return name
+	}
+
+	out := jsonErd{
+		Title:     nonEmpty(e.Title.TitleAttributes),
+		Graph:     nonEmpty(e.GraphAttributes),
+		Tables:    []jsonTable{},
+		Relations: []jsonRelation{},
+	}
+	for _, name := range e.TableNames {
+		t := e.Tables[name]
+		table := jsonTable{Name: t.Title, Attributes: nonEmpty(t.TableAttributes), Columns: []jsonColumn{}}
+		if t.Extends != "" {
+			table.Extends = title(t.Extends)
+		}
+		table.Columns = append(table.Columns, columns(t.Columns)...)
+		out.Tables = append(out.Tables, table)
+	}
+	for _, r := range e.Relations {
+		out.Relations = append(out.Relations, jsonRelation{
+			Left:             title(r.LeftTableName),
+			LeftCardinality:  r.LeftCardinality,
+			Right:            title(r.RightTableName),
+			RightCardinality: r.RightCardinality,
+			Identifying:      r.Identifying,
+			Attributes:       nonEmpty(r.RelationAttributes),
+		})
+	}
+	for _, r := range e.Relationships {
+		relationship := jsonRelationship{
+			Name:       r.Title,
+			Attributes: nonEmpty(r.RelationshipAttributes),
+			Columns:    columns(r.Columns),
+		}
+		for _, p := range r.Participants {
+			relationship.Participants = append(relationship.Participants,
+				jsonParticipant{Table: title(p.TableName), Cardinality: p.Cardinality})
+		}
+		out.Relationships = append(out.Relationships, relationship)
+	}
+	for _, en := range e.Enums {
+		out.Enums = append(out.Enums, jsonEnum{Name: en.Title, Values: append([]string{}, en.Values...)})
+	}
+	for _, n := range e.Notes {
+		out.Notes = append(out.Notes, jsonNote{Text: n.Text, Attributes: nonEmpty(n.NoteAttributes)})
+	}
+	for _, g := range e.Groups {
+		group := jsonGroup{Name: g.Title, Attributes: nonEmpty(g.GroupAttributes), Tables: []string{}}
+		for _, name := range g.TableNames {
+			group.Tables = append(group.Tables, title(name))
+		}
+		out.Groups = append(out.Groups, group)
+	}
+	return json.Marshal(out)
+}
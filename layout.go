@@ -0,0 +1,211 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// layoutAttribute is a Graphviz attribute which may be set with the graph
+// directive or the layout flags
+type layoutAttribute struct {
+	blocks []string // the attribute blocks it is set in: graph, node, edge
+	valid  func(string) bool
+	want   string // the values accepted, for errors
+}
+
+func oneOf(values ...string) func(string) bool {
+	return func(v string) bool {
+		for _, value := range values {
+			if v == value {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func isNumber(v string) bool {
+	f, err := strconv.ParseFloat(v, 64)
+	return err == nil && f >= 0
+}
+
+func isPoint(v string) bool {
+	for _, f := range strings.Split(strings.TrimSuffix(v, "!"), ",") {
+		if !isNumber(f) {
+			return false
+		}
+	}
+	return true
+}
+
+func notBlank(v string) bool {
+	return strings.TrimSpace(v) != ""
+}
+
+var (
+	graphBlock = []string{"graph"}
+	nodeBlock  = []string{"node"}
+	edgeBlock  = []string{"edge"}
+	allBlocks  = []string{"graph", "node", "edge"}
+	isBool     = oneOf("true", "false")
+)
+
+// layoutAttributes are the Graphviz attributes known to the graph directive
+var layoutAttributes = map[string]layoutAttribute{
+	"rankdir":       {graphBlock, oneOf("TB", "LR", "BT", "RL"), "TB, LR, BT or RL"},
+	"splines":       {graphBlock, oneOf("none", "line", "false", "polyline", "curved", "ortho", "spline", "true"), "none, line, polyline, curved, ortho or spline"},
+	"concentrate":   {graphBlock, isBool, "true or false"},
+	"newrank":       {graphBlock, isBool, "true or false"},
+	"ordering":      {graphBlock, oneOf("in", "out"), "in or out"},
+	"nodesep":       {graphBlock, isNumber, "a number"},
+	"ranksep":       {graphBlock, isNumber, "a number"},
+	"pad":           {graphBlock, isPoint, "a number or x,y"},
+	"margin":        {graphBlock, isPoint, "a number or x,y"},
+	"size":          {graphBlock, isPoint, "a number or x,y"},
+	"ratio":         {graphBlock, func(v string) bool { return isNumber(v) || oneOf("fill", "compress", "expand", "auto")(v) }, "a number, fill, compress, expand or auto"},
+	"dpi":           {graphBlock, isNumber, "a number"},
+	"bgcolor":       {graphBlock, notBlank, "a color"},
+	"fontname":      {allBlocks, notBlank, "a font name"},
+	"fontsize":      {allBlocks, isNumber, "a number"},
+	"fontcolor":     {allBlocks, notBlank, "a color"},
+	"color":         {[]string{"node", "edge"}, notBlank, "a color"},
+	"penwidth":      {[]string{"node", "edge"}, isNumber, "a number"},
+	"shape":         {nodeBlock, oneOf("Mrecord", "record", "box", "rect", "rectangle", "plaintext", "plain", "none"), "Mrecord, record, box, plaintext or none"},
+	"arrowsize":     {edgeBlock, isNumber, "a number"},
+	"labelangle":    {edgeBlock, func(v string) bool { _, err := strconv.ParseFloat(v, 64); return err == nil }, "a number"},
+	"labeldistance": {edgeBlock, isNumber, "a number"},
+}
+
+// checkLayout checks a key and value of the graph directive. The key is a
+// Graphviz attribute, which may be restricted to one block as in node.fontsize.
+func checkLayout(key, value string) error {
+	block, name := "", key
+	if i := strings.IndexByte(key, '.'); i >= 0 {
+		block, name = key[:i], key[i+1:]
+	}
+	a, ok := layoutAttributes[name]
+	if !ok {
+		return fmt.Errorf("unknown graph attribute %q", key)
+	}
+	if block != "" && !oneOf(a.blocks...)(block) {
+		return fmt.Errorf("graph attribute %q cannot be set on %s", name, block)
+	}
+	if !a.valid(value) {
+		return fmt.Errorf("invalid %s %q, want %s", key, value, a.want)
+	}
+	return nil
+}
+
+// GraphvizAttribute is a key and value of an attribute block of the output
+type GraphvizAttribute struct {
+	Key   string
+	Value string
+}
+
+var plainID = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*|-?([0-9]+(\.[0-9]*)?|\.[0-9]+))$`)
+
+// String returns the attribute in the DOT syntax, quoting the value if needed
+func (a GraphvizAttribute) String() string {
+	if plainID.MatchString(a.Value) {
+		return a.Key + "=" + a.Value
+	}
+	return a.Key + "=" + strconv.Quote(a.Value)
+}
+
+// Layout is the graph, node and edge attribute blocks of the output
+type Layout struct {
+	Graph []GraphvizAttribute
+	Node  []GraphvizAttribute
+	Edge  []GraphvizAttribute
+}
+
+// setAttribute replaces the attribute in the block, or appends it
+func setAttribute(block []GraphvizAttribute, key, value string) []GraphvizAttribute {
+	for i, a := range block {
+		if a.Key == key {
+			block[i].Value = value
+			return block
+		}
+	}
+	return append(block, GraphvizAttribute{Key: key, Value: value})
+}
+
+// Layout returns the attribute blocks of the output: the defaults, with the
+// attributes of the theme and of the graph directive applied
+func (e *Erd) Layout() Layout {
+	l := Layout{
+		Graph: []GraphvizAttribute{
+			{"nodesep", "0.5"},
+			{"ranksep", "0.5"},
+			{"pad", "0.2,0.2"},
+			{"margin", "0.0"},
+			{"splines", "spline"},
+			{"rankdir", "LR"},
+		},
+		Node: []GraphvizAttribute{
+			{"fontsize", "14"},
+			{"margin", "0.07,0.05"},
+			{"penwidth", "1.0"},
+			{"shape", "Mrecord"},
+		},
+		Edge: []GraphvizAttribute{
+			{"fontsize", "12"},
+			{"arrowsize", "0.9"},
+			{"penwidth", "1.0"},
+			{"labelangle", "32"},
+			{"labeldistance", "1.8"},
+		},
+	}
+
+	// the theme first, so the graph directive overrides it
+	l.apply(e.Theme().layout())
+	l.apply(e.GraphAttributes)
+	return l
+}
+
+// apply sets the attributes in their blocks
+func (l *Layout) apply(attrs map[string]string) {
+	var keys []string
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	// the attributes of all blocks first, so node.fontsize wins over fontsize
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := strings.Contains(keys[i], "."), strings.Contains(keys[j], ".")
+		if a != b {
+			return b
+		}
+		return keys[i] < keys[j]
+	})
+	for _, k := range keys {
+		v := attrs[k]
+		blocks, name := []string(nil), k
+		if i := strings.IndexByte(k, '.'); i >= 0 {
+			blocks, name = []string{k[:i]}, k[i+1:]
+		} else {
+			blocks = layoutAttributes[k].blocks
+		}
+		for _, b := range blocks {
+			switch b {
+			case "graph":
+				l.Graph = setAttribute(l.Graph, name, v)
+			case "node":
+				l.Node = setAttribute(l.Node, name, v)
+			case "edge":
+				l.Edge = setAttribute(l.Edge, name, v)
+			}
+		}
+	}
+}
+
+// Font returns the font set by the graph directive or the flags for the
+// tables, which replaces the fonts of the theme
+func (e *Erd) Font() string {
+	if f := e.GraphAttributes["node.fontname"]; f != "" {
+		return f
+	}
+	return e.GraphAttributes["fontname"]
+}
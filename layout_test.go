@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestErd_Layout(t *testing.T) {
+	erd, err := parseErd(`graph {rankdir: TB, fontname: "Fira Sans", node.fontname: Inter, edge.color: "#999"}
+[a]
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := erd.Layout()
+	tests := []struct {
+		block []GraphvizAttribute
+		want  string
+	}{
+		{l.Graph, `[nodesep=0.5 ranksep=0.5 pad="0.2,0.2" margin=0.0 splines=spline rankdir=TB fontname="Fira Sans"]`},
+		{l.Node, `[fontsize=14 margin="0.07,0.05" penwidth=1.0 shape=Mrecord fontname=Inter]`},
+		{l.Edge, `[fontsize=12 arrowsize=0.9 penwidth=1.0 labelangle=32 labeldistance=1.8 fontname="Fira Sans" color="#999"]`},
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprint(tt.block); got != tt.want {
+			t.Errorf("got: %v\nwant: %v", got, tt.want)
+		}
+	}
+	if erd.Font() != "Inter" {
+		t.Errorf("got: %v\nwant: %v", erd.Font(), "Inter")
+	}
+}
+
+func TestCheckLayout(t *testing.T) {
+	tests := []struct {
+		key, value string
+		want       string
+	}{
+		{"splines", "ortho", ""},
+		{"node.fontsize", "10", ""},
+		{"pad", "0.5,1", ""},
+		{"cencentrate", "true", `unknown graph attribute "cencentrate"`},
+		{"concentrate", "yes", `invalid concentrate "yes", want true or false`},
+		{"graph.arrowsize", "2", `graph attribute "arrowsize" cannot be set on graph`},
+	}
+	for _, tt := range tests {
+		got := ""
+		if err := checkLayout(tt.key, tt.value); err != nil {
+			got = err.Error()
+		}
+		if got != tt.want {
+			t.Errorf("%s: %s\ngot: %v\nwant: %v", tt.key, tt.value, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,736 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// LspCommand runs a language server for .er files over stdio
+type LspCommand struct{}
+
+var lspCommand LspCommand
+
+// Execute serves LSP requests on stdin and stdout until the client exits
+func (c *LspCommand) Execute(args []string) error {
+	return newLspServer(os.Stdin, os.Stdout).run()
+}
+
+type lspRequest struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      *json.RawMessage `json:"id,omitempty"`
+	Method  string           `json:"method"`
+	Params  json.RawMessage  `json:"params,omitempty"`
+}
+
+type lspResponse struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      *json.RawMessage `json:"id"`
+	Result  interface{}      `json:"result"`
+}
+
+type lspErrorResponse struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      *json.RawMessage `json:"id"`
+	Error   *lspError        `json:"error"`
+}
+
+type lspNotification struct {
+	JSONRPC string      `json:"jsonrpc"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params"`
+}
+
+// lspError is a JSON-RPC error
+type lspError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *lspError) Error() string {
+	return e.Message
+}
+
+// JSON-RPC error codes
+const (
+	lspMethodNotFound = -32601
+	lspInvalidParams  = -32602
+)
+
+type lspPosition struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+type lspRange struct {
+	Start lspPosition `json:"start"`
+	End   lspPosition `json:"end"`
+}
+
+type lspLocation struct {
+	URI   string   `json:"uri"`
+	Range lspRange `json:"range"`
+}
+
+type lspTextEdit struct {
+	Range   lspRange `json:"range"`
+	NewText string   `json:"newText"`
+}
+
+type lspDiagnostic struct {
+	Range    lspRange `json:"range"`
+	Severity int      `json:"severity"`
+	Source   string   `json:"source"`
+	Message  string   `json:"message"`
+}
+
+type lspTextDocument struct {
+	URI  string `json:"uri"`
+	Text string `json:"text"`
+}
+
+type lspPositionParams struct {
+	TextDocument lspTextDocument `json:"textDocument"`
+	Position     lspPosition     `json:"position"`
+}
+
+type lspCompletionItem struct {
+	Label  string `json:"label"`
+	Kind   int    `json:"kind"`
+	Detail string `json:"detail,omitempty"`
+}
+
+type lspMarkupContent struct {
+	Kind  string `json:"kind"`
+	Value string `json:"value"`
+}
+
+type lspHover struct {
+	Contents lspMarkupContent `json:"contents"`
+	Range    lspRange         `json:"range"`
+}
+
+type lspDocumentSymbol struct {
+	Name           string              `json:"name"`
+	Detail         string              `json:"detail,omitempty"`
+	Kind           int                 `json:"kind"`
+	Range          lspRange            `json:"range"`
+	SelectionRange lspRange            `json:"selectionRange"`
+	Children       []lspDocumentSymbol `json:"children,omitempty"`
+}
+
+type lspWorkspaceEdit struct {
+	Changes map[string][]lspTextEdit `json:"changes"`
+}
+
+// Values of the protocol enumerations used by the server
+const (
+	lspSeverityError   = 1
+	lspSeverityWarning = 2
+
+	lspCompletionClass = 7
+	lspCompletionEnum  = 13
+	lspCompletionColor = 16
+
+	lspSymbolNamespace  = 3
+	lspSymbolClass      = 5
+	lspSymbolField      = 8
+	lspSymbolEnum       = 10
+	lspSymbolConstant   = 14
+	lspSymbolString     = 15
+	lspSymbolEnumMember = 22
+	lspSymbolOperator   = 25
+)
+
+// lspDocument is an open .er file
+type lspDocument struct {
+	text string
+	tree *SyntaxFile // last version of the text which could be parsed
+}
+
+type lspServer struct {
+	in   *textproto.Reader
+	out  io.Writer
+	docs map[string]*lspDocument
+}
+
+func newLspServer(in io.Reader, out io.Writer) *lspServer {
+	return &lspServer{
+		in:   textproto.NewReader(bufio.NewReader(in)),
+		out:  out,
+		docs: map[string]*lspDocument{},
+	}
+}
+
+// read reads one message framed by a Content-Length header
+func (s *lspServer) read() (*lspRequest, error) {
+	header, err := s.in.ReadMIMEHeader()
+	if err != nil {
+		return nil, err
+	}
+	length, err := strconv.Atoi(header.Get("Content-Length"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid Content-Length: %v", err)
+	}
+	body := make([]byte, length)
+	_, err = io.ReadFull(s.in.R, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var req lspRequest
+	err = json.Unmarshal(body, &req)
+	return &req, err
+}
+
+func (s *lspServer) write(msg interface{}) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(s.out, "Content-Length: %d\r\n\r\n%s", len(body), body)
+	return err
+}
+
+func (s *lspServer) run() error {
+	for {
+		req, err := s.read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if req.Method == "exit" {
+			return nil
+		}
+
+		result, err := s.handle(req)
+		if req.ID == nil {
+			if err != nil {
+				logStderr.Printf("%s: %v\n", req.Method, err)
+			}
+			continue
+		}
+		if err != nil {
+			lerr, ok := err.(*lspError)
+			if !ok {
+				lerr = &lspError{Code: lspInvalidParams, Message: err.Error()}
+			}
+			err = s.write(lspErrorResponse{JSONRPC: "2.0", ID: req.ID, Error: lerr})
+		} else {
+			err = s.write(lspResponse{JSONRPC: "2.0", ID: req.ID, Result: result})
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *lspServer) handle(req *lspRequest) (interface{}, error) {
+	switch req.Method {
+	case "initialize":
+		return map[string]interface{}{
+			"capabilities": map[string]interface{}{
+				"textDocumentSync":           1,
+				"completionProvider":         map[string]interface{}{"triggerCharacters": []string{" ", ":", "\"", ","}},
+				"definitionProvider":         true,
+				"hoverProvider":              true,
+				"documentSymbolProvider":     true,
+				"renameProvider":             true,
+				"documentFormattingProvider": true,
+			},
+			"serverInfo": map[string]string{"name": "erd-go"},
+		}, nil
+	case "initialized", "shutdown", "$/cancelRequest", "$/setTrace":
+		return nil, nil
+	case "textDocument/didOpen":
+		var params struct {
+			TextDocument lspTextDocument `json:"textDocument"`
+		}
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil, err
+		}
+		return nil, s.update(params.TextDocument.URI, params.TextDocument.Text)
+	case "textDocument/didChange":
+		var params struct {
+			TextDocument   lspTextDocument `json:"textDocument"`
+			ContentChanges []struct {
+				Text string `json:"text"`
+			} `json:"contentChanges"`
+		}
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil, err
+		}
+		if n := len(params.ContentChanges); n > 0 {
+			return nil, s.update(params.TextDocument.URI, params.ContentChanges[n-1].Text)
+		}
+		return nil, nil
+	case "textDocument/didClose":
+		var params struct {
+			TextDocument lspTextDocument `json:"textDocument"`
+		}
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil, err
+		}
+		delete(s.docs, params.TextDocument.URI)
+		return nil, nil
+	case "textDocument/completion":
+		return s.positionRequest(req, s.completion)
+	case "textDocument/definition":
+		return s.positionRequest(req, s.definition)
+	case "textDocument/hover":
+		return s.positionRequest(req, s.hover)
+	case "textDocument/rename":
+		var params struct {
+			lspPositionParams
+			NewName string `json:"newName"`
+		}
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil, err
+		}
+		doc, offset, err := s.document(params.lspPositionParams)
+		if err != nil {
+			return nil, err
+		}
+		return s.rename(params.TextDocument.URI, doc, offset, params.NewName)
+	case "textDocument/documentSymbol":
+		var params struct {
+			TextDocument lspTextDocument `json:"textDocument"`
+		}
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil, err
+		}
+		doc, ok := s.docs[params.TextDocument.URI]
+		if !ok {
+			return nil, fmt.Errorf("unknown document %s", params.TextDocument.URI)
+		}
+		return s.symbols(doc), nil
+	case "textDocument/formatting":
+		var params struct {
+			TextDocument lspTextDocument `json:"textDocument"`
+		}
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil, err
+		}
+		doc, ok := s.docs[params.TextDocument.URI]
+		if !ok {
+			return nil, fmt.Errorf("unknown document %s", params.TextDocument.URI)
+		}
+		return s.formatting(doc)
+	}
+	if req.ID == nil {
+		return nil, nil
+	}
+	return nil, &lspError{Code: lspMethodNotFound, Message: "method not found: " + req.Method}
+}
+
+// update stores the new text of a document and publishes its diagnostics
+func (s *lspServer) update(uri, text string) error {
+	doc, ok := s.docs[uri]
+	if !ok {
+		doc = &lspDocument{}
+		s.docs[uri] = doc
+	}
+	doc.text = text
+
+	diagnostics := []lspDiagnostic{}
+	tree, err := ParseSyntax(text)
+	if err != nil {
+		begin := 0
+		if serr, ok := err.(*SyntaxError); ok {
+			begin = serr.Offset
+		}
+		end := begin + strings.IndexAny(text[begin:]+"\n", "\r\n")
+		diagnostics = append(diagnostics, lspDiagnostic{
+			Range:    lspRange{Start: lspPositionOf(text, begin), End: lspPositionOf(text, end)},
+			Severity: lspSeverityError,
+			Source:   "erd",
+			Message:  err.Error(),
+		})
+	} else {
+		doc.tree = tree
+		for _, p := range Validate(tree) {
+			severity := lspSeverityError
+			if p.Warning {
+				severity = lspSeverityWarning
+			}
+			diagnostics = append(diagnostics, lspDiagnostic{
+				Range:    lspRange{Start: lspPositionOf(text, p.Begin.Offset), End: lspPositionOf(text, p.End.Offset)},
+				Severity: severity,
+				Source:   "erd",
+				Message:  p.Message,
+			})
+		}
+	}
+
+	return s.write(lspNotification{
+		JSONRPC: "2.0",
+		Method:  "textDocument/publishDiagnostics",
+		Params: map[string]interface{}{
+			"uri":         uri,
+			"diagnostics": diagnostics,
+		},
+	})
+}
+
+// document returns the document and byte offset a request points at
+func (s *lspServer) document(params lspPositionParams) (*lspDocument, int, error) {
+	doc, ok := s.docs[params.TextDocument.URI]
+	if !ok {
+		return nil, 0, fmt.Errorf("unknown document %s", params.TextDocument.URI)
+	}
+	return doc, lspOffsetOf(doc.text, params.Position), nil
+}
+
+func (s *lspServer) positionRequest(req *lspRequest, fn func(uri string, doc *lspDocument, offset int) (interface{}, error)) (interface{}, error) {
+	var params lspPositionParams
+	if err := json.Unmarshal(req.Params, &params); err != nil {
+		return nil, err
+	}
+	doc, offset, err := s.document(params)
+	if err != nil {
+		return nil, err
+	}
+	return fn(params.TextDocument.URI, doc, offset)
+}
+
+// lspPositionOf converts a byte offset to a protocol position, which counts
+// characters in UTF-16 code units
+func lspPositionOf(text string, offset int) lspPosition {
+	if offset > len(text) {
+		offset = len(text)
+	}
+	before := text[:offset]
+	lineStart := strings.LastIndex(before, "\n") + 1
+	return lspPosition{
+		Line:      strings.Count(before, "\n"),
+		Character: len(utf16.Encode([]rune(before[lineStart:]))),
+	}
+}
+
+// lspOffsetOf converts a protocol position to a byte offset
+func lspOffsetOf(text string, pos lspPosition) int {
+	offset := 0
+	for i := 0; i < pos.Line; i++ {
+		n := strings.Index(text[offset:], "\n")
+		if n < 0 {
+			return len(text)
+		}
+		offset += n + 1
+	}
+	for units := 0; units < pos.Character && offset < len(text); {
+		r, size := utf8.DecodeRuneInString(text[offset:])
+		if r == '\n' {
+			break
+		}
+		units += len(utf16.Encode([]rune{r}))
+		offset += size
+	}
+	return offset
+}
+
+func lspRangeOf(text string, n *SyntaxNode) lspRange {
+	return lspRange{Start: lspPositionOf(text, n.Begin.Offset), End: lspPositionOf(text, n.End.Offset)}
+}
+
+var (
+	// a relation up to its right table, the members of a group, the parent
+	// of a table or the table a note is attached to
+	lspTableContext = regexp.MustCompile(`(^\s*\S+\s*[01?*+](--|==)[01?*+]\s*\S*$)|(^\s*group\s.*\{[^:}]*$)|(^\[[^\]]*\]\s+extends\s+\S*$)|(^\s*note\s.*attach\s*:\s*"?[^",}\s]*$)`)
+	// the value of a color attribute
+	lspColorContext = regexp.MustCompile(`color\s*:\s*"?[^",}\s]*$`)
+	// the value of a type attribute
+	lspTypeContext = regexp.MustCompile(`(^|[{,\s])type\s*:\s*"?[^",}\s]*$`)
+)
+
+// completion offers table names in relations, groups and extends, palette
+// colors in color attributes and enums in type attributes
+func (s *lspServer) completion(uri string, doc *lspDocument, offset int) (interface{}, error) {
+	items := []lspCompletionItem{}
+	if doc.tree == nil {
+		return items, nil
+	}
+	line := doc.text[strings.LastIndex(doc.text[:offset], "\n")+1 : offset]
+
+	switch {
+	case lspColorContext.MatchString(line):
+		for _, n := range doc.tree.Statements {
+			if n.Kind != SyntaxColors {
+				continue
+			}
+			for _, a := range n.ChildrenOf(SyntaxAttribute) {
+				items = append(items, lspCompletionItem{
+					Label:  a.Child(SyntaxKey).Text,
+					Kind:   lspCompletionColor,
+					Detail: a.Child(SyntaxValue).Text,
+				})
+			}
+		}
+	case lspTypeContext.MatchString(line):
+		for _, n := range doc.tree.Statements {
+			if n.Kind == SyntaxEnum {
+				items = append(items, lspCompletionItem{
+					Label:  n.Child(SyntaxName).Text,
+					Kind:   lspCompletionEnum,
+					Detail: fmt.Sprintf("%d values", len(n.ChildrenOf(SyntaxValue))),
+				})
+			}
+		}
+	case lspTableContext.MatchString(line):
+		for _, n := range doc.tree.Statements {
+			if n.Kind == SyntaxTable {
+				items = append(items, lspCompletionItem{
+					Label:  n.Child(SyntaxName).Text,
+					Kind:   lspCompletionClass,
+					Detail: fmt.Sprintf("%d columns", len(n.ChildrenOf(SyntaxColumn))),
+				})
+			}
+		}
+	}
+	return items, nil
+}
+
+// tableAt returns the table named by the reference or table name at the offset
+func (doc *lspDocument) tableAt(offset int) (*SyntaxNode, *SyntaxNode) {
+	if doc.tree == nil || doc.tree.Source != doc.text {
+		return nil, nil
+	}
+	path := doc.tree.NodeAt(offset)
+	if len(path) == 0 {
+		return nil, nil
+	}
+	n := path[len(path)-1]
+	if n.Kind != SyntaxReference && !(n.Kind == SyntaxName && path[0].Kind == SyntaxTable && len(path) == 2) {
+		return nil, nil
+	}
+	for _, s := range doc.tree.Statements {
+		if s.Kind == SyntaxTable && replaceAllIllegal(s.Child(SyntaxName).Text) == replaceAllIllegal(n.Text) {
+			return n, s
+		}
+	}
+	return n, nil
+}
+
+func (s *lspServer) definition(uri string, doc *lspDocument, offset int) (interface{}, error) {
+	_, table := doc.tableAt(offset)
+	if table == nil {
+		return nil, nil
+	}
+	return lspLocation{URI: uri, Range: lspRangeOf(doc.text, table.Child(SyntaxName))}, nil
+}
+
+func (s *lspServer) hover(uri string, doc *lspDocument, offset int) (interface{}, error) {
+	n, table := doc.tableAt(offset)
+	if table == nil {
+		return nil, nil
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "**%s**", table.Child(SyntaxName).Text)
+	if attrs := (&printer{f: doc.tree}).attributes(table); attrs != "" {
+		fmt.Fprintf(&sb, " `%s`", attrs)
+	}
+	sb.WriteString("\n\n")
+	for _, c := range table.ChildrenOf(SyntaxColumn) {
+		fmt.Fprintf(&sb, "- `%s`", c.Child(SyntaxName).Text)
+		if def := (&printer{f: doc.tree}).definition(c); def != "" {
+			fmt.Fprintf(&sb, " %s", def)
+		}
+		for _, a := range c.ChildrenOf(SyntaxAttribute) {
+			fmt.Fprintf(&sb, " %s: %s", a.Child(SyntaxKey).Text, a.Child(SyntaxValue).Text)
+		}
+		sb.WriteString("\n")
+	}
+	for _, note := range doc.notesOf(table) {
+		sb.WriteString("\n> " + strings.Replace(note, "\n", "\n> ", -1) + "\n")
+	}
+	return lspHover{
+		Contents: lspMarkupContent{Kind: "markdown", Value: sb.String()},
+		Range:    lspRangeOf(doc.text, n),
+	}, nil
+}
+
+// notesOf returns the text of the notes attached to the table or one of its
+// columns
+func (doc *lspDocument) notesOf(table *SyntaxNode) []string {
+	name := replaceAllIllegal(table.Child(SyntaxName).Text)
+	var notes []string
+	for _, n := range doc.tree.Statements {
+		if n.Kind != SyntaxNote {
+			continue
+		}
+		for _, a := range n.ChildrenOf(SyntaxAttribute) {
+			attach := a.Child(SyntaxValue).Text
+			if a.Child(SyntaxKey).Text != "attach" {
+				continue
+			}
+			if replaceAllIllegal(attach) == name {
+				notes = append(notes, n.Child(SyntaxValue).Text)
+			} else if i := strings.LastIndex(attach, "."); i >= 0 && replaceAllIllegal(attach[:i]) == name {
+				notes = append(notes, "`"+attach[i+1:]+"`: "+n.Child(SyntaxValue).Text)
+			}
+		}
+	}
+	return notes
+}
+
+var lspTableName = regexp.MustCompile(`^[^"\t\r\n/:,\[\]{} ]+$`)
+
+// rename renames a table and every relation, group member and note
+// attachment referring to it
+func (s *lspServer) rename(uri string, doc *lspDocument, offset int, newName string) (interface{}, error) {
+	n, _ := doc.tableAt(offset)
+	if n == nil {
+		return nil, &lspError{Code: lspInvalidParams, Message: "no table at this position"}
+	}
+	if !lspTableName.MatchString(newName) {
+		return nil, &lspError{Code: lspInvalidParams, Message: fmt.Sprintf("invalid table name %q", newName)}
+	}
+
+	name := replaceAllIllegal(n.Text)
+	edits := []lspTextEdit{}
+	doc.tree.Walk(func(c *SyntaxNode) {
+		if (c.Kind == SyntaxName || c.Kind == SyntaxReference) && replaceAllIllegal(c.Text) == name {
+			if c.Kind == SyntaxName && !doc.isTableName(c) {
+				return
+			}
+			edits = append(edits, lspTextEdit{Range: lspRangeOf(doc.text, c), NewText: newName})
+		}
+		if c.Kind == SyntaxNote {
+			for _, a := range c.ChildrenOf(SyntaxAttribute) {
+				if a.Child(SyntaxKey).Text != "attach" {
+					continue
+				}
+				if edit, ok := doc.attachEdit(a.Child(SyntaxValue), name, newName); ok {
+					edits = append(edits, edit)
+				}
+			}
+		}
+	})
+	return lspWorkspaceEdit{Changes: map[string][]lspTextEdit{uri: edits}}, nil
+}
+
+// attachEdit renames the table in the attach value of a note, the whole
+// value or the table part of table.column
+func (doc *lspDocument) attachEdit(value *SyntaxNode, name, newName string) (lspTextEdit, bool) {
+	begin := value.Begin.Offset
+	if strings.HasPrefix(doc.tree.Raw(value), `"`) {
+		begin++
+	}
+	end := -1
+	if replaceAllIllegal(value.Text) == name {
+		end = begin + len(value.Text)
+	} else if i := strings.LastIndex(value.Text, "."); i >= 0 && replaceAllIllegal(value.Text[:i]) == name {
+		end = begin + i
+	}
+	if end < 0 {
+		return lspTextEdit{}, false
+	}
+	r := lspRange{Start: lspPositionOf(doc.text, begin), End: lspPositionOf(doc.text, end)}
+	return lspTextEdit{Range: r, NewText: newName}, true
+}
+
+func (doc *lspDocument) isTableName(n *SyntaxNode) bool {
+	for _, s := range doc.tree.Statements {
+		if s.Kind == SyntaxTable && s.Child(SyntaxName) == n {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *lspServer) symbols(doc *lspDocument) []lspDocumentSymbol {
+	symbols := []lspDocumentSymbol{}
+	if doc.tree == nil {
+		return symbols
+	}
+	text := doc.tree.Source
+	for _, n := range doc.tree.Statements {
+		symbol := lspDocumentSymbol{Range: lspRangeOf(text, n), SelectionRange: lspRangeOf(text, n)}
+		switch n.Kind {
+		case SyntaxTable, SyntaxRelationship:
+			name := n.Child(SyntaxName)
+			symbol.Name, symbol.Kind, symbol.SelectionRange = name.Text, lspSymbolClass, lspRangeOf(text, name)
+			if n.Kind == SyntaxRelationship {
+				symbol.Name, symbol.Kind = "<"+name.Text+">", lspSymbolOperator
+			} else if parent := n.Child(SyntaxReference); parent != nil {
+				symbol.Detail = "extends " + parent.Text
+			}
+			for _, c := range n.ChildrenOf(SyntaxColumn) {
+				cname := c.Child(SyntaxName)
+				symbol.Children = append(symbol.Children, lspDocumentSymbol{
+					Name:           cname.Text,
+					Kind:           lspSymbolField,
+					Range:          lspRangeOf(text, c),
+					SelectionRange: lspRangeOf(text, cname),
+				})
+			}
+		case SyntaxGroup:
+			name := n.Child(SyntaxName)
+			symbol.Name, symbol.Kind, symbol.SelectionRange = name.Text, lspSymbolNamespace, lspRangeOf(text, name)
+		case SyntaxEnum:
+			name := n.Child(SyntaxName)
+			symbol.Name, symbol.Kind, symbol.SelectionRange = name.Text, lspSymbolEnum, lspRangeOf(text, name)
+			for _, v := range n.ChildrenOf(SyntaxValue) {
+				symbol.Children = append(symbol.Children, lspDocumentSymbol{
+					Name:           v.Text,
+					Kind:           lspSymbolEnumMember,
+					Range:          lspRangeOf(text, v),
+					SelectionRange: lspRangeOf(text, v),
+				})
+			}
+		case SyntaxNote:
+			value := n.Child(SyntaxValue)
+			symbol.Name, symbol.Kind, symbol.SelectionRange = strings.Split(value.Text, "\n")[0], lspSymbolString, lspRangeOf(text, value)
+			for _, a := range n.ChildrenOf(SyntaxAttribute) {
+				if a.Child(SyntaxKey).Text == "attach" {
+					symbol.Detail = "attached to " + a.Child(SyntaxValue).Text
+				}
+			}
+		case SyntaxColors:
+			symbol.Name, symbol.Kind = "colors", lspSymbolNamespace
+			for _, a := range n.ChildrenOf(SyntaxAttribute) {
+				symbol.Children = append(symbol.Children, lspDocumentSymbol{
+					Name:           a.Child(SyntaxKey).Text,
+					Detail:         a.Child(SyntaxValue).Text,
+					Kind:           lspSymbolConstant,
+					Range:          lspRangeOf(text, a),
+					SelectionRange: lspRangeOf(text, a.Child(SyntaxKey)),
+				})
+			}
+		case SyntaxRelation:
+			refs := n.ChildrenOf(SyntaxReference)
+			cards := n.ChildrenOf(SyntaxCardinality)
+			symbol.Name = refs[0].Text + " " + cards[0].Text + n.Child(SyntaxOperator).Text + cards[1].Text + " " + refs[1].Text
+			symbol.Kind = lspSymbolOperator
+		default:
+			continue
+		}
+		symbols = append(symbols, symbol)
+	}
+	return symbols
+}
+
+func (s *lspServer) formatting(doc *lspDocument) (interface{}, error) {
+	tree, err := ParseSyntax(doc.text)
+	if err != nil {
+		return nil, err
+	}
+	out := Format(tree, false)
+	if out == doc.text {
+		return []lspTextEdit{}, nil
+	}
+	return []lspTextEdit{{
+		Range:   lspRange{Start: lspPosition{}, End: lspPositionOf(doc.text, len(doc.text))},
+		NewText: out,
+	}}, nil
+}
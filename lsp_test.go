@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+const lspSource = `colors {
+    person: "#fcecec",
+}
+
+[Person] {bgcolor: "person"}
+  *name
+  +birth_location_id
+
+[Location]
+  *id
+
+Person *--1 Location
+Person *--1 Nowhere
+
+note "where people are born" {attach: "Location"}
+note "the key" {attach: "Location.id"}
+`
+
+// lspSession runs the server over the given messages and returns what it wrote
+func lspSession(t *testing.T, messages ...interface{}) []map[string]interface{} {
+	var in bytes.Buffer
+	for _, m := range messages {
+		body, err := json.Marshal(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fmt.Fprintf(&in, "Content-Length: %d\r\n\r\n%s", len(body), body)
+	}
+	var out bytes.Buffer
+	if err := newLspServer(&in, &out).run(); err != nil {
+		t.Fatal(err)
+	}
+
+	var replies []map[string]interface{}
+	for _, part := range strings.Split(out.String(), "Content-Length: ")[1:] {
+		var reply map[string]interface{}
+		body := part[strings.Index(part, "\r\n\r\n")+4:]
+		if err := json.Unmarshal([]byte(body), &reply); err != nil {
+			t.Fatal(err)
+		}
+		replies = append(replies, reply)
+	}
+	return replies
+}
+
+func lspCall(id int, method string, params interface{}) map[string]interface{} {
+	return map[string]interface{}{"jsonrpc": "2.0", "id": id, "method": method, "params": params}
+}
+
+func lspAt(line, character int) map[string]interface{} {
+	return map[string]interface{}{
+		"textDocument": map[string]string{"uri": "file:///schema.er"},
+		"position":     map[string]int{"line": line, "character": character},
+	}
+}
+
+func TestLspServer(t *testing.T) {
+	open := map[string]interface{}{
+		"jsonrpc": "2.0",
+		"method":  "textDocument/didOpen",
+		"params": map[string]interface{}{
+			"textDocument": map[string]string{"uri": "file:///schema.er", "text": lspSource},
+		},
+	}
+	rename := lspAt(11, 14)
+	rename["newName"] = "Place"
+
+	replies := lspSession(t,
+		lspCall(1, "initialize", map[string]interface{}{}),
+		open,
+		lspCall(2, "textDocument/definition", lspAt(11, 15)),
+		lspCall(3, "textDocument/hover", lspAt(11, 2)),
+		lspCall(4, "textDocument/completion", lspAt(11, 12)),
+		lspCall(5, "textDocument/completion", lspAt(4, 22)),
+		lspCall(6, "textDocument/rename", rename),
+		lspCall(7, "textDocument/documentSymbol", lspAt(0, 0)),
+		lspCall(8, "textDocument/formatting", lspAt(0, 0)),
+		lspCall(9, "shutdown", nil),
+		map[string]interface{}{"jsonrpc": "2.0", "method": "exit"},
+	)
+	if len(replies) != 10 {
+		t.Fatalf("got: %v replies\nwant: 10", len(replies))
+	}
+	encode := func(v interface{}) string {
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+
+	diagnostics := encode(replies[1]["params"])
+	if !strings.Contains(diagnostics, `unknown table \"Nowhere\"`) {
+		t.Errorf("diagnostics: %v", diagnostics)
+	}
+	if got := encode(replies[2]["result"]); got != `{"range":{"end":{"character":9,"line":8},"start":{"character":1,"line":8}},"uri":"file:///schema.er"}` {
+		t.Errorf("definition: %v", got)
+	}
+	if got := encode(replies[3]["result"]); !strings.Contains(got, "birth_location_id") {
+		t.Errorf("hover: %v", got)
+	}
+	if got := encode(replies[4]["result"]); !strings.Contains(got, `"label":"Location"`) {
+		t.Errorf("table completion: %v", got)
+	}
+	if got := encode(replies[5]["result"]); !strings.Contains(got, `"label":"person"`) {
+		t.Errorf("color completion: %v", got)
+	}
+	if got := encode(replies[6]["result"]); strings.Count(got, `"newText":"Place"`) != 4 ||
+		!strings.Contains(got, `{"newText":"Place","range":{"end":{"character":33,"line":15},"start":{"character":25,"line":15}}}`) {
+		t.Errorf("rename: %v", got)
+	}
+	if got := encode(replies[7]["result"]); !strings.Contains(got, `"name":"+birth_location_id"`) {
+		t.Errorf("symbols: %v", got)
+	}
+	if got := encode(replies[8]["result"]); got != "[]" {
+		t.Errorf("formatting: %v", got)
+	}
+}
+
+func TestLspPosition(t *testing.T) {
+	text := "a\n😀b\n"
+	offset := strings.Index(text, "b")
+	pos := lspPositionOf(text, offset)
+	if pos.Line != 1 || pos.Character != 2 {
+		t.Errorf("got: %+v\nwant: 1:2", pos)
+	}
+	if got := lspOffsetOf(text, pos); got != offset {
+		t.Errorf("got: %v\nwant: %v", got, offset)
+	}
+}
@@ -0,0 +1,436 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// MigrateCommand writes the SQL migrating one schema to another
+type MigrateCommand struct {
+	Dialect string `long:"dialect" default:"postgres" choice:"postgres" choice:"mysql" choice:"sqlite" description:"SQL dialect of the statements."`
+	Down    bool   `long:"down" description:"print the down migration, from the new schema back to the old one."`
+	Dir     string `long:"dir" description:"write <version>_<name>.up.sql and .down.sql for golang-migrate to the directory."`
+	Name    string `long:"name" default:"schema" description:"name of the migration files written with --dir."`
+}
+
+var migrateCommand MigrateCommand
+
+// migration is the SQL of one direction of a migration
+type migration struct {
+	dialect    *sqlDialect
+	statements []string
+	warnings   []string
+}
+
+func (m *migration) add(format string, args ...interface{}) {
+	m.statements = append(m.statements, fmt.Sprintf(format, args...))
+}
+
+func (m *migration) warn(format string, args ...interface{}) {
+	m.warnings = append(m.warnings, fmt.Sprintf(format, args...))
+}
+
+// String returns the migration as an SQL script, the warnings as comments
+func (m *migration) String() string {
+	var sb strings.Builder
+	for _, w := range m.warnings {
+		sb.WriteString("-- warning: " + w + "\n")
+	}
+	if len(m.warnings) > 0 {
+		sb.WriteString("\n")
+	}
+	for _, s := range m.statements {
+		sb.WriteString(s + ";\n")
+	}
+	return sb.String()
+}
+
+// Execute prints the up (or down) migration, or writes both with --dir
+func (c *MigrateCommand) Execute(args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("usage: migrate OLD NEW")
+	}
+	var erds [2]*Erd
+	for i, pattern := range args {
+		inputs, err := readInputs([]string{pattern})
+		if err != nil {
+			return err
+		}
+		erds[i], err = load(inputs)
+		if err != nil {
+			return err
+		}
+	}
+	dialect := sqlDialects[c.Dialect]
+	up := Migrate(erds[0], erds[1], dialect)
+	down := Migrate(erds[1], erds[0], dialect)
+
+	if len(up.statements) == 0 {
+		logStderr.Println("no changes")
+		return nil
+	}
+	for _, w := range up.warnings {
+		logStderr.Println("warning: " + w)
+	}
+
+	if c.Dir != "" {
+		version := time.Now().UTC().Format("20060102150405")
+		base := filepath.Join(c.Dir, version+"_"+replaceAllIllegal(c.Name))
+		err := os.MkdirAll(c.Dir, 0755)
+		if err != nil {
+			return err
+		}
+		err = ioutil.WriteFile(base+".up.sql", []byte(up.String()), 0644)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(base+".down.sql", []byte(down.String()), 0644)
+	}
+	if c.Down {
+		fmt.Print(down.String())
+	} else {
+		fmt.Print(up.String())
+	}
+	return nil
+}
+
+// foreignKeyIDs maps the foreign keys by what they connect, with the tables
+// renamed, and returns the ids in order
+func foreignKeyIDs(fks []foreignKey, rename map[string]string) (map[string]foreignKey, []string) {
+	keys := map[string]foreignKey{}
+	var ids []string
+	for _, fk := range fks {
+		table, ref := fk.Table, fk.RefTable
+		if n, ok := rename[table]; ok {
+			table = n
+		}
+		if n, ok := rename[ref]; ok {
+			ref = n
+		}
+		id := fmt.Sprint(table, fk.Columns, ref, fk.RefColumns)
+		keys[id] = fk
+		ids = append(ids, id)
+	}
+	return keys, ids
+}
+
+// Migrate returns the statements changing the old schema into the new one.
+// Constraints are dropped first and added last, so the statements in
+// between do not trip over them.
+func Migrate(old, new *Erd, dialect *sqlDialect) *migration {
+	m := &migration{dialect: dialect}
+	d := DiffSchemas(old, new)
+	ident := dialect.ident
+	sqlite := dialect.Name == "sqlite"
+
+	renamed := map[string]string{} // old titles to new titles
+	removed := map[string]bool{}
+	added := map[string]bool{}
+	for _, tc := range d.Tables {
+		switch tc.Kind {
+		case ChangeRenamed:
+			renamed[tc.OldName] = tc.Name
+		case ChangeRemoved:
+			removed[tc.Name] = true
+		case ChangeAdded:
+			added[tc.Name] = true
+		}
+	}
+
+	oldKeys, newKeys := foreignKeys(old), foreignKeys(new)
+	oldFKs, oldIDs := foreignKeyIDs(oldKeys, renamed)
+	newFKs, newIDs := foreignKeyIDs(newKeys, nil)
+	for _, id := range oldIDs {
+		fk := oldFKs[id]
+		if _, ok := newFKs[id]; ok || removed[fk.Table] {
+			continue
+		}
+		if sqlite {
+			m.warn("sqlite cannot drop the foreign key %s of %s", fk.Name, fk.Table)
+			continue
+		}
+		drop := "DROP CONSTRAINT"
+		if dialect.Name == "mysql" {
+			drop = "DROP FOREIGN KEY"
+		}
+		m.add("ALTER TABLE %s %s %s", ident(fk.Table), drop, ident(fk.Name))
+	}
+
+	for _, tc := range d.Tables {
+		if tc.Kind == ChangeRenamed {
+			m.add("ALTER TABLE %s RENAME TO %s", ident(tc.OldName), ident(tc.Name))
+		}
+	}
+	if dialect.Name == "postgres" {
+		migrateEnumTypes(m, new, d)
+	}
+
+	for _, tc := range d.Tables {
+		if tc.Kind != ChangeAdded {
+			continue
+		}
+		var inline []foreignKey
+		if sqlite {
+			// sqlite only knows the foreign keys declared with the table
+			for _, id := range newIDs {
+				if fk := newFKs[id]; fk.Table == tc.Name {
+					inline = append(inline, fk)
+				}
+			}
+		}
+		t := new.Tables[replaceAllIllegal(tc.Name)]
+		create, untyped := dialect.createTable(new, t, keyColumns(t, newKeys), inline)
+		for _, c := range untyped {
+			m.warn("column %s.%s has no type, using %s", tc.Name, c, dialect.DefaultType)
+		}
+		m.add("%s", create)
+	}
+
+	for _, tc := range d.Tables {
+		if tc.Kind == ChangeChanged || tc.Kind == ChangeRenamed {
+			migrateColumns(m, old, new, tc, oldKeys, newKeys)
+		}
+	}
+	if dialect.Name == "mysql" {
+		migrateEnumColumns(m, old, new, d)
+	}
+
+	for _, tc := range d.Tables {
+		if tc.Kind == ChangeRemoved {
+			m.warn("dropping table %s deletes its data", tc.Name)
+			m.add("DROP TABLE %s", ident(tc.Name))
+		}
+	}
+	if dialect.Name == "postgres" {
+		for _, ec := range d.Enums {
+			if ec.Kind == ChangeRemoved {
+				m.add("DROP TYPE %s", ident(ec.Name))
+			}
+		}
+	}
+
+	for _, id := range newIDs {
+		fk := newFKs[id]
+		if _, ok := oldFKs[id]; ok {
+			continue
+		}
+		if sqlite {
+			if !added[fk.Table] {
+				m.warn("sqlite cannot add the foreign key %s to the existing table %s", fk.Name, fk.Table)
+			}
+			continue
+		}
+		m.add("ALTER TABLE %s ADD %s", ident(fk.Table), dialect.constraint(fk))
+	}
+	return m
+}
+
+// migrateEnumTypes adds the statements creating the added enum types and
+// adding the new values of the changed ones, for postgres
+func migrateEnumTypes(m *migration, new *Erd, d *SchemaDiff) {
+	ident := m.dialect.ident
+	for _, ec := range d.Enums {
+		switch ec.Kind {
+		case ChangeAdded:
+			m.add("CREATE TYPE %s AS ENUM (%s)", ident(ec.Name), enumValues(new.enum(ec.Name)))
+		case ChangeChanged:
+			for _, v := range ec.Added {
+				m.add("ALTER TYPE %s ADD VALUE %s", ident(ec.Name), literal(v))
+			}
+			for _, v := range ec.Removed {
+				m.warn("postgres cannot drop the value %s of the enum %s", literal(v), ec.Name)
+			}
+		}
+	}
+}
+
+// migrateEnumColumns adds the statements changing the columns of the
+// existing tables whose enum has changed, for mysql which spells out the
+// values in every column
+func migrateEnumColumns(m *migration, old, new *Erd, d *SchemaDiff) {
+	ident := m.dialect.ident
+	oldTitles := map[string]string{} // of the tables, by new title
+	for _, tc := range d.Tables {
+		if tc.Kind == ChangeRenamed {
+			oldTitles[tc.Name] = tc.OldName
+		}
+	}
+	for _, ec := range d.Enums {
+		if ec.Kind != ChangeChanged {
+			continue
+		}
+		en := new.enum(ec.Name)
+		for _, name := range new.TableNames {
+			t := new.Tables[name]
+			oldTitle, ok := oldTitles[t.Title]
+			if !ok {
+				oldTitle = t.Title
+			}
+			ot, ok := old.Tables[replaceAllIllegal(oldTitle)]
+			if !ok {
+				continue
+			}
+			for _, c := range t.Columns {
+				if new.EnumOf(c) != en {
+					continue
+				}
+				// added columns and changed types are handled with the table
+				for _, oc := range ot.Columns {
+					if columnName(oc.Title) == columnName(c.Title) && oc.Type == en.Title {
+						typ, _ := m.dialect.columnType(c, new)
+						m.add("ALTER TABLE %s MODIFY COLUMN %s %s", ident(t.Title), ident(columnName(c.Title)), typ)
+						if len(ec.Removed) > 0 {
+							m.warn("removing values from %s.%s fails for the rows using them", t.Title, columnName(c.Title))
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// migrateColumn adds the statements changing the definition of a column
+func migrateColumn(m *migration, new *Erd, tableName string, c Column, cc ColumnChange) {
+	dialect, ident := m.dialect, m.dialect.ident
+	table, name := ident(tableName), ident(cc.Name)
+	changed := map[string]AttributeChange{}
+	for _, a := range cc.Attributes {
+		changed[a.Key] = a
+	}
+	typeChange, typeChanged := changed["type"]
+	_, nullChanged := changed["null"]
+	_, defaultChanged := changed["default"]
+	typ, _ := dialect.columnType(c, new)
+
+	switch dialect.Name {
+	case "postgres":
+		if typeChanged {
+			m.add("ALTER TABLE %s ALTER COLUMN %s TYPE %s", table, name, typ)
+		}
+		if nullChanged && c.NotNull {
+			m.add("ALTER TABLE %s ALTER COLUMN %s SET NOT NULL", table, name)
+		} else if nullChanged {
+			m.add("ALTER TABLE %s ALTER COLUMN %s DROP NOT NULL", table, name)
+		}
+		if defaultChanged && c.Default != "" {
+			m.add("ALTER TABLE %s ALTER COLUMN %s SET DEFAULT %s", table, name, c.Default)
+		} else if defaultChanged {
+			m.add("ALTER TABLE %s ALTER COLUMN %s DROP DEFAULT", table, name)
+		}
+	case "mysql":
+		if typeChanged || nullChanged || defaultChanged {
+			def, _ := dialect.columnDefinition(c, new, false)
+			m.add("ALTER TABLE %s MODIFY COLUMN %s %s", table, name, def)
+		}
+	default:
+		if typeChanged {
+			m.warn("sqlite cannot change the type of %s.%s to %s", tableName, cc.Name, typ)
+		}
+		for _, key := range []string{"null", "default", "unique", "check"} {
+			if _, ok := changed[key]; ok {
+				m.warn("sqlite cannot change the %s of %s.%s", key, tableName, cc.Name)
+			}
+		}
+		return
+	}
+	if typeChanged {
+		from := typeChange.Old
+		if from == "" {
+			from = dialect.DefaultType
+		}
+		m.warn("changing the type of %s.%s from %s to %s may lose data", tableName, cc.Name, from, typ)
+	}
+
+	// the constraints are named like postgres names them
+	if _, ok := changed["unique"]; ok {
+		key := ident(tableName + "_" + cc.Name + "_key")
+		switch {
+		case c.Unique && dialect.Name == "mysql":
+			m.add("ALTER TABLE %s ADD UNIQUE (%s)", table, name)
+		case c.Unique:
+			m.add("ALTER TABLE %s ADD CONSTRAINT %s UNIQUE (%s)", table, key, name)
+		case dialect.Name == "mysql":
+			m.add("ALTER TABLE %s DROP INDEX %s", table, name)
+		default:
+			m.add("ALTER TABLE %s DROP CONSTRAINT %s", table, key)
+		}
+	}
+	if check, ok := changed["check"]; ok {
+		if dialect.Name == "mysql" {
+			m.warn("the check of %s.%s is not migrated in mysql", tableName, cc.Name)
+			return
+		}
+		key := ident(tableName + "_" + cc.Name + "_check")
+		if check.Old != "" {
+			m.add("ALTER TABLE %s DROP CONSTRAINT %s", table, key)
+		}
+		if c.Check != "" {
+			m.add("ALTER TABLE %s ADD CONSTRAINT %s CHECK (%s)", table, key, c.Check)
+		}
+	}
+}
+
+// migrateColumns adds the statements changing the columns of a table
+func migrateColumns(m *migration, old, new *Erd, tc TableChange, oldFKs, newFKs []foreignKey) {
+	dialect, ident := m.dialect, m.dialect.ident
+	oldName := tc.Name
+	if tc.Kind == ChangeRenamed {
+		oldName = tc.OldName
+	}
+	o, n := old.Tables[replaceAllIllegal(oldName)], new.Tables[replaceAllIllegal(tc.Name)]
+	table := ident(tc.Name)
+	column := func(t *Table, name string) Column {
+		for _, c := range t.Columns {
+			if columnName(c.Title) == name {
+				return c
+			}
+		}
+		return Column{}
+	}
+
+	oldKey, newKey := keyColumns(o, oldFKs), keyColumns(n, newFKs)
+	keyChanged := !reflect.DeepEqual(oldKey, newKey)
+	if keyChanged && dialect.Name == "sqlite" {
+		m.warn("sqlite cannot change the primary key of %s", tc.Name)
+		keyChanged = false
+	}
+	if keyChanged && len(oldKey) > 0 {
+		if dialect.Name == "mysql" {
+			m.add("ALTER TABLE %s DROP PRIMARY KEY", table)
+		} else {
+			m.add("ALTER TABLE %s DROP CONSTRAINT %s", table, ident(oldName+"_pkey"))
+		}
+	}
+
+	for _, cc := range tc.Columns {
+		switch cc.Kind {
+		case ChangeAdded:
+			c := column(n, cc.Name)
+			def, ok := dialect.columnDefinition(c, new, true)
+			if !ok {
+				m.warn("column %s.%s has no type, using %s", tc.Name, cc.Name, dialect.DefaultType)
+			}
+			if c.NotNull && c.Default == "" {
+				m.warn("adding the not null column %s.%s without a default fails if %s has rows", tc.Name, cc.Name, tc.Name)
+			}
+			m.add("ALTER TABLE %s ADD COLUMN %s %s", table, ident(cc.Name), def)
+		case ChangeChanged:
+			migrateColumn(m, new, tc.Name, column(n, cc.Name), cc)
+		}
+	}
+	for _, cc := range tc.Columns {
+		if cc.Kind == ChangeRemoved {
+			m.warn("dropping column %s.%s deletes its data", tc.Name, cc.Name)
+			m.add("ALTER TABLE %s DROP COLUMN %s", table, ident(cc.Name))
+		}
+	}
+
+	if keyChanged && len(newKey) > 0 {
+		m.add("ALTER TABLE %s ADD PRIMARY KEY (%s)", table, dialect.idents(newKey))
+	}
+}
@@ -0,0 +1,279 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+const migrateOld = `
+[person]
+*id {type: "integer"}
+name {type: "varchar(50)"}
+fax {type: "text"}
+
+[people_address]
+*id {type: "integer"}
+person_id {type: "integer"}
+street {type: "text"}
+
+[legacy]
+*id {type: "integer"}
++person_id {type: "integer"}
+
+person 1--* people_address
+legacy *--1 person
+`
+
+const migrateNew = `
+[person]
+*id {type: "integer"}
+name {type: "text"}
++team_member_id {type: "integer"}
+
+[address]
+*id {type: "integer"}
+person_id {type: "integer"}
+street {type: "text"}
+
+[team-member]
+*id {type: "integer"}
+title
+
+person 1--* address
+person *--1 team-member
+`
+
+func TestMigrate(t *testing.T) {
+	old, err := parseErd(migrateOld)
+	if err != nil {
+		t.Fatal(err)
+	}
+	new, err := parseErd(migrateNew)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		dialect string
+		old     *Erd
+		new     *Erd
+		want    string
+	}{
+		{"postgres", old, new, `-- warning: column team-member.title has no type, using text
+-- warning: changing the type of person.name from varchar(50) to text may lose data
+-- warning: dropping column person.fax deletes its data
+-- warning: dropping table legacy deletes its data
+
+ALTER TABLE people_address RENAME TO address;
+CREATE TABLE "team-member" (
+  id integer,
+  title text,
+  PRIMARY KEY (id)
+);
+ALTER TABLE person ALTER COLUMN name TYPE text;
+ALTER TABLE person ADD COLUMN team_member_id integer;
+ALTER TABLE person DROP COLUMN fax;
+DROP TABLE legacy;
+ALTER TABLE person ADD CONSTRAINT fk_person_team_member FOREIGN KEY (team_member_id) REFERENCES "team-member" (id);
+`},
+		{"mysql", new, old, `-- warning: changing the type of person.name from text to varchar(50) may lose data
+-- warning: dropping column person.team_member_id deletes its data
+-- warning: dropping table team-member deletes its data
+
+ALTER TABLE person DROP FOREIGN KEY fk_person_team_member;
+ALTER TABLE address RENAME TO people_address;
+CREATE TABLE legacy (
+  id integer,
+  person_id integer,
+  PRIMARY KEY (id)
+);
+ALTER TABLE person MODIFY COLUMN name varchar(50);
+ALTER TABLE person ADD COLUMN fax text;
+ALTER TABLE person DROP COLUMN team_member_id;
+DROP TABLE ` + "`team-member`" + `;
+ALTER TABLE legacy ADD CONSTRAINT fk_legacy_person FOREIGN KEY (person_id) REFERENCES person (id);
+`},
+		{"sqlite", old, new, `-- warning: column team-member.title has no type, using TEXT
+-- warning: sqlite cannot change the type of person.name to text
+-- warning: dropping column person.fax deletes its data
+-- warning: dropping table legacy deletes its data
+-- warning: sqlite cannot add the foreign key fk_person_team_member to the existing table person
+
+ALTER TABLE people_address RENAME TO address;
+CREATE TABLE "team-member" (
+  id integer,
+  title TEXT,
+  PRIMARY KEY (id)
+);
+ALTER TABLE person ADD COLUMN team_member_id integer;
+ALTER TABLE person DROP COLUMN fax;
+DROP TABLE legacy;
+`},
+	}
+	for _, tt := range tests {
+		got := Migrate(tt.old, tt.new, sqlDialects[tt.dialect]).String()
+		if got != tt.want {
+			t.Errorf("%s: got: %s\nwant: %s", tt.dialect, got, tt.want)
+		}
+	}
+}
+
+func TestForeignKeys(t *testing.T) {
+	erd, err := parseErd(`
+[a]
+*id
+[b]
+*id
+a_id
+[c]
+*id
++id_b
+[d]
+*id
++id
+a 1--* b
+b 1--* c
+a *--* d
+a 1--1 d
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, fk := range foreignKeys(erd) {
+		got = append(got, fk.Name+"("+strings.Join(fk.Columns, ",")+")")
+	}
+	if strings.Join(got, " ") != "fk_b_a(a_id) fk_d_a(id)" {
+		t.Errorf("got: %v\nwant: %v", got, "fk_b_a(a_id) fk_d_a(id)")
+	}
+}
+
+func TestMigrate_identifying(t *testing.T) {
+	old, err := parseErd("[game]\n*id {type: int}\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	new, err := parseErd("[game]\n*id {type: int}\n[drive]\n*drive_id {type: int}\n+game_id {type: int}\ngame 1==* drive\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `CREATE TABLE drive (
+  drive_id int,
+  game_id int,
+  PRIMARY KEY (drive_id, game_id)
+);
+ALTER TABLE drive ADD CONSTRAINT fk_drive_game FOREIGN KEY (game_id) REFERENCES game (id);
+`
+	if got := Migrate(old, new, sqlDialects["postgres"]).String(); got != want {
+		t.Errorf("got: %s\nwant: %s", got, want)
+	}
+}
+
+func TestMigrate_subtypes(t *testing.T) {
+	old, err := parseErd("[Party]\n*id {type: int}\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	new, err := parseErd("[Party]\n*id {type: int}\n[Person] extends Party\n*party_id {type: int}\nname {type: text}\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `CREATE TABLE Person (
+  party_id int,
+  name text,
+  PRIMARY KEY (party_id)
+);
+ALTER TABLE Person ADD CONSTRAINT fk_Person_Party FOREIGN KEY (party_id) REFERENCES Party (id);
+`
+	if got := Migrate(old, new, sqlDialects["postgres"]).String(); got != want {
+		t.Errorf("got: %s\nwant: %s", got, want)
+	}
+}
+
+func TestMigrate_enums(t *testing.T) {
+	old, err := parseErd("enum pos {QB, RB}\nenum old {x}\n[player]\n*id {type: int}\nposition {type: pos}\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	new, err := parseErd("enum pos {QB, WR}\nenum phase {Regular}\n[player]\n*id {type: int}\nposition {type: pos}\n[game]\nseason {type: phase}\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		dialect string
+		want    string
+	}{
+		{"postgres", `-- warning: postgres cannot drop the value 'RB' of the enum pos
+
+ALTER TYPE pos ADD VALUE 'WR';
+CREATE TYPE phase AS ENUM ('Regular');
+CREATE TABLE game (
+  season phase
+);
+DROP TYPE old;
+`},
+		{"mysql", `-- warning: removing values from player.position fails for the rows using them
+
+CREATE TABLE game (
+  season ENUM('Regular')
+);
+ALTER TABLE player MODIFY COLUMN position ENUM('QB', 'WR');
+`},
+		{"sqlite", `CREATE TABLE game (
+  season TEXT
+);
+`},
+	}
+	for _, tt := range tests {
+		if got := Migrate(old, new, sqlDialects[tt.dialect]).String(); got != tt.want {
+			t.Errorf("%s\ngot: %s\nwant: %s", tt.dialect, got, tt.want)
+		}
+	}
+}
+
+func TestMigrate_definitions(t *testing.T) {
+	old, err := parseErd("[team]\n*id int\nname text\ncity text unique\nsize int check (size > 0)\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	new, err := parseErd("[team]\n*id int\nname text not null default 'x'\ncity text\nsize int check (size > 1)\nfounded date not null\n[stadium]\n*id int not null\nseats int default 0 check (seats >= 0)\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		dialect string
+		want    string
+	}{
+		{"postgres", `-- warning: adding the not null column team.founded without a default fails if team has rows
+
+CREATE TABLE stadium (
+  id int NOT NULL,
+  seats int DEFAULT 0 CHECK (seats >= 0),
+  PRIMARY KEY (id)
+);
+ALTER TABLE team ALTER COLUMN name SET NOT NULL;
+ALTER TABLE team ALTER COLUMN name SET DEFAULT 'x';
+ALTER TABLE team DROP CONSTRAINT team_city_key;
+ALTER TABLE team DROP CONSTRAINT team_size_check;
+ALTER TABLE team ADD CONSTRAINT team_size_check CHECK (size > 1);
+ALTER TABLE team ADD COLUMN founded date NOT NULL;
+`},
+		{"mysql", `-- warning: the check of team.size is not migrated in mysql
+-- warning: adding the not null column team.founded without a default fails if team has rows
+
+CREATE TABLE stadium (
+  id int NOT NULL,
+  seats int DEFAULT 0 CHECK (seats >= 0),
+  PRIMARY KEY (id)
+);
+ALTER TABLE team MODIFY COLUMN name text NOT NULL DEFAULT 'x';
+ALTER TABLE team DROP INDEX city;
+ALTER TABLE team ADD COLUMN founded date NOT NULL;
+`},
+	}
+	for _, tt := range tests {
+		if got := Migrate(old, new, sqlDialects[tt.dialect]).String(); got != tt.want {
+			t.Errorf("%s\ngot: %s\nwant: %s", tt.dialect, got, tt.want)
+		}
+	}
+}
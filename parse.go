@@ -15,6 +15,76 @@ type Relation struct {
 	RightTableName     string
 	RightCardinality   string
 	RelationAttributes map[string]string
+	Identifying        bool   // written == or with identifying: true
+	Change             string // added, removed or changed in a diff
+}
+
+// Operator returns the operator of the relation, == if identifying or --
+func (r Relation) Operator() string {
+	if r.Identifying {
+		return "=="
+	}
+	return "--"
+}
+
+// Participant of a relationship
+type Participant struct {
+	TableName   string
+	Cardinality string
+}
+
+// Relationship between any number of tables, drawn as a diamond. Like an
+// associative entity, it may have columns of its own.
+type Relationship struct {
+	Name                   string
+	Title                  string
+	Participants           []Participant
+	RelationshipAttributes map[string]string
+	Columns                []Column
+}
+
+// has reports whether the table takes part in the relationship
+func (r *Relationship) has(name string) bool {
+	for _, p := range r.Participants {
+		if p.TableName == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Enum is a type with a fixed set of values, used by the columns whose type
+// attribute names it
+type Enum struct {
+	Name   string
+	Title  string
+	Values []string
+}
+
+// EnumLink joins a column to the node of its enum
+type EnumLink struct {
+	Table string
+	Port  string // of the column, empty when the column is hidden
+	Enum  string
+}
+
+// Note is a free-floating annotation, attached to a table or a column
+// (table.column) with the attach attribute
+type Note struct {
+	Name           string
+	Text           string
+	NoteAttributes map[string]string
+}
+
+// NoteNode is a note drawn in the diagram, from a note statement or from
+// the note attribute of a table or column
+type NoteNode struct {
+	Name    string
+	Text    string
+	Color   string
+	BgColor string
+	Table   string // the table the note is attached to, if any
+	Port    string // of the column the note is attached to
 }
 
 // Index on a column
@@ -27,7 +97,13 @@ type Index struct {
 // Column in a table
 type Column struct {
 	Title            string
+	Type             string // SQL type, like varchar(36)
+	NotNull          bool
+	Default          string // SQL expression, like 'x' or now()
+	Unique           bool
+	Check            string // SQL condition, without the parentheses
 	ColumnAttributes map[string]string
+	Change           string // added, removed or changed in a diff
 }
 
 // Table in a database
@@ -39,6 +115,16 @@ type Table struct {
 	CurrentColumnID int
 	PrimaryKeys     []int
 	Connected       bool
+	Extends         string // name of the supertype
+	Change          string // added, removed or changed in a diff
+}
+
+// Group of tables rendered as a cluster
+type Group struct {
+	Name            string
+	Title           string
+	GroupAttributes map[string]string
+	TableNames      []string
 }
 
 // Title ...
@@ -49,17 +135,29 @@ type Title struct {
 
 // Erd of the database
 type Erd struct {
-	Title            Title
-	Tables           map[string]*Table
-	Relations        []Relation
-	CurrentRelation  Relation
-	TableNames       []string // for ordering Isolations
-	Isolations       []string
-	key              string
-	value            string
-	CurrentTableName string
-	IsError          bool
-	Colors           map[string]string
+	Title               Title
+	GraphAttributes     map[string]string // Graphviz attributes of the graph directive
+	Tables              map[string]*Table
+	Relations           []Relation
+	Relationships       []*Relationship
+	Enums               []*Enum
+	Notes               []*Note
+	Groups              []*Group
+	CurrentGroup        *Group
+	CurrentEnum         *Enum
+	CurrentNote         *Note
+	CurrentRelation     Relation
+	TableNames          []string // for ordering Isolations
+	Isolations          []string
+	Stubs               []Stub // tables collapsed by a Filter
+	ColumnMode          string // all, keys or none
+	theme               *Theme // chosen with --theme or the title
+	key                 string
+	value               string
+	CurrentTableName    string
+	CurrentRelationship *Relationship
+	IsError             bool
+	Colors              map[string]string
 }
 
 var re = regexp.MustCompile(`[^a-zA-Z0-9\\_]`)
@@ -68,6 +166,99 @@ func replaceAllIllegal(text string) string {
 	return re.ReplaceAllString(text, "_")
 }
 
+// IsKey reports whether the column is a primary (*) or foreign (+) key
+func (c Column) IsKey() bool {
+	return strings.HasPrefix(c.Title, "*") || strings.HasPrefix(c.Title, "+")
+}
+
+// Port returns the port of the column in the table node
+func (c Column) Port() string {
+	return replaceAllIllegal(columnName(c.Title))
+}
+
+// Flag reports whether a yes or no attribute of the column, like bold, is set
+func (c Column) Flag(key string) bool {
+	return isTrue(c.ColumnAttributes[key])
+}
+
+// isTrue reports whether a yes or no attribute value means yes
+func isTrue(value string) bool {
+	switch strings.ToLower(value) {
+	case "true", "yes", "1":
+		return true
+	}
+	return false
+}
+
+// TypeLabel describes the type of the column, like varchar(36) not null
+func (c Column) TypeLabel() string {
+	label := c.Type
+	if c.NotNull {
+		label += " not null"
+	}
+	if c.Unique {
+		label += " unique"
+	}
+	return strings.TrimSpace(label)
+}
+
+// VisibleColumns returns the columns to render for the given column mode
+func (t *Table) VisibleColumns(mode string) []Column {
+	switch mode {
+	case "none":
+		return nil
+	case "keys":
+		var columns []Column
+		for _, c := range t.Columns {
+			if c.IsKey() {
+				columns = append(columns, c)
+			}
+		}
+		return columns
+	}
+	return t.Columns
+}
+
+// Flag reports whether a yes or no attribute of the table, like disjoint, is set
+func (t *Table) Flag(key string) bool {
+	return isTrue(t.TableAttributes[key])
+}
+
+// HasTypes reports whether any column of the table has a type or constraint
+// to show
+func (t *Table) HasTypes() bool {
+	for _, c := range t.Columns {
+		if c.TypeLabel() != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// SubtypeConstraints returns the constraints of the generalisation of a
+// subtype, like {disjoint, incomplete}, as far as they are given
+func (t *Table) SubtypeConstraints() string {
+	var constraints []string
+	if _, ok := t.TableAttributes["disjoint"]; ok {
+		if t.Flag("disjoint") {
+			constraints = append(constraints, "disjoint")
+		} else {
+			constraints = append(constraints, "overlapping")
+		}
+	}
+	if _, ok := t.TableAttributes["complete"]; ok {
+		if t.Flag("complete") {
+			constraints = append(constraints, "complete")
+		} else {
+			constraints = append(constraints, "incomplete")
+		}
+	}
+	if len(constraints) == 0 {
+		return ""
+	}
+	return "{" + strings.Join(constraints, ", ") + "}"
+}
+
 // Connect marks the table is connected to another
 func (t *Table) Connect() {
 	t.Connected = true
@@ -76,6 +267,7 @@ func (t *Table) Connect() {
 // ClearTableAndColumn clears the current table
 func (e *Erd) ClearTableAndColumn() {
 	e.CurrentTableName = ""
+	e.CurrentRelationship = nil
 }
 
 // AddTitleKeyValue adds the key value pair to the title attributes
@@ -86,6 +278,14 @@ func (e *Erd) AddTitleKeyValue() {
 	e.Title.TitleAttributes[e.key] = e.value
 }
 
+// AddGraphKeyValue adds the key value pair to the graph attributes
+func (e *Erd) AddGraphKeyValue() {
+	if e.GraphAttributes == nil {
+		e.GraphAttributes = map[string]string{}
+	}
+	e.GraphAttributes[e.key] = e.value
+}
+
 // AddTable adds a table to the EDR
 func (e *Erd) AddTable(text string) {
 	if e.Tables == nil {
@@ -95,6 +295,12 @@ func (e *Erd) AddTable(text string) {
 	e.Tables[name] = &Table{Name: name, Title: text, TableAttributes: map[string]string{}}
 	e.TableNames = append(e.TableNames, name)
 	e.CurrentTableName = name
+	e.CurrentRelationship = nil
+}
+
+// SetTableParent sets the supertype of the current table
+func (e *Erd) SetTableParent(text string) {
+	e.Tables[e.CurrentTableName].Extends = replaceAllIllegal(text)
 }
 
 // AddTableKeyValue add a key value pair to the table attributes
@@ -104,14 +310,256 @@ func (e *Erd) AddTableKeyValue() {
 		table.TableAttributes = map[string]string{}
 	}
 
-	val := e.value
+	table.TableAttributes[e.key] = e.colorValue()
+
+	if e.key == "group" {
+		e.group(e.value).addTable(table.Name)
+	}
+}
+
+// group returns the group with the given title, creating it if needed
+func (e *Erd) group(text string) *Group {
+	name := replaceAllIllegal(text)
+	for _, g := range e.Groups {
+		if g.Name == name {
+			return g
+		}
+	}
+	g := &Group{Name: name, Title: text, GroupAttributes: map[string]string{}}
+	e.Groups = append(e.Groups, g)
+	return g
+}
+
+func (g *Group) addTable(name string) {
+	for _, n := range g.TableNames {
+		if n == name {
+			return
+		}
+	}
+	g.TableNames = append(g.TableNames, name)
+}
+
+// checkGroups reports a table put in two groups, as Graphviz draws a node
+// in one cluster only
+func (e *Erd) checkGroups() error {
+	seen := map[string]*Group{}
+	for _, g := range e.Groups {
+		for _, name := range g.TableNames {
+			if first, ok := seen[name]; ok {
+				return fmt.Errorf("table %q is in groups %q and %q", name, first.Title, g.Title)
+			}
+			seen[name] = g
+		}
+	}
+	return nil
+}
+
+// AddGroup starts a group declaration
+func (e *Erd) AddGroup(text string) {
+	if len(text) > 0 && text[0] == '"' {
+		text = e.unquote(text)
+	}
+	e.CurrentGroup = e.group(text)
+}
+
+// AddGroupKeyValue adds a key value pair to the current group attributes
+func (e *Erd) AddGroupKeyValue() {
+	e.CurrentGroup.GroupAttributes[e.key] = e.colorValue()
+}
+
+// AddGroupMember adds a table to the current group
+func (e *Erd) AddGroupMember(text string) {
+	e.CurrentGroup.addTable(replaceAllIllegal(text))
+}
+
+// AddEnum starts an enum declaration
+func (e *Erd) AddEnum(text string) {
+	e.CurrentEnum = &Enum{Name: replaceAllIllegal(text) + "__enum", Title: text}
+	e.Enums = append(e.Enums, e.CurrentEnum)
+}
+
+// AddEnumValue adds a value to the current enum
+func (e *Erd) AddEnumValue(text string) {
+	if len(text) > 0 && text[0] == '"' {
+		text = e.unquote(text)
+	}
+	e.CurrentEnum.Values = append(e.CurrentEnum.Values, text)
+}
+
+// enum returns the enum with the given title, or nil
+func (e *Erd) enum(title string) *Enum {
+	for _, en := range e.Enums {
+		if en.Title == title {
+			return en
+		}
+	}
+	return nil
+}
+
+// EnumOf returns the enum named by the type of the column, or nil
+func (e *Erd) EnumOf(c Column) *Enum {
+	if c.Type != "" {
+		return e.enum(c.Type)
+	}
+	return nil
+}
+
+// EnumLinks returns the links from the columns typed with an enum to the
+// enum, in table order
+func (e *Erd) EnumLinks() []EnumLink {
+	var links []EnumLink
+	for _, name := range e.TableNames {
+		t, ok := e.Tables[name]
+		if !ok {
+			continue
+		}
+		visible := map[string]bool{}
+		for _, c := range t.VisibleColumns(e.ColumnMode) {
+			visible[c.Title] = true
+		}
+		for _, c := range t.Columns {
+			en := e.EnumOf(c)
+			if en == nil {
+				continue
+			}
+			link := EnumLink{Table: t.Name, Enum: en.Name}
+			if visible[c.Title] {
+				link.Port = c.Port()
+			}
+			links = append(links, link)
+		}
+	}
+	return links
+}
+
+// AddNote starts a note statement
+func (e *Erd) AddNote(text string) {
+	e.CurrentNote = &Note{
+		Name:           fmt.Sprintf("note__%d", len(e.Notes)),
+		Text:           e.unquote(text),
+		NoteAttributes: map[string]string{},
+	}
+	e.Notes = append(e.Notes, e.CurrentNote)
+}
+
+// AddNoteKeyValue adds a key value pair to the current note attributes
+func (e *Erd) AddNoteKeyValue() {
+	e.CurrentNote.NoteAttributes[e.key] = e.colorValue()
+}
+
+// attachment returns the table and the column, if any, a note is attached
+// to. Table names may contain dots, so the whole name is tried first.
+func (e *Erd) attachment(n *Note) (*Table, *Column) {
+	attach := n.NoteAttributes["attach"]
+	if attach == "" {
+		return nil, nil
+	}
+	if t, ok := e.lookupTable(attach); ok {
+		return t, nil
+	}
+	i := strings.LastIndex(attach, ".")
+	if i < 0 {
+		return nil, nil
+	}
+	t, ok := e.lookupTable(attach[:i])
+	if !ok {
+		return nil, nil
+	}
+	for j, c := range t.Columns {
+		if columnName(c.Title) == attach[i+1:] {
+			return t, &t.Columns[j]
+		}
+	}
+	return nil, nil
+}
+
+// NoteNodes returns the notes to draw: the note statements, and the note
+// attributes of the tables and columns
+func (e *Erd) NoteNodes() []NoteNode {
+	// port returns the port of a column, unless it is hidden
+	port := func(t *Table, c Column) string {
+		for _, v := range t.VisibleColumns(e.ColumnMode) {
+			if v.Title == c.Title {
+				return c.Port()
+			}
+		}
+		return ""
+	}
+
+	var nodes []NoteNode
+	for _, n := range e.Notes {
+		node := NoteNode{
+			Name:    n.Name,
+			Text:    n.Text,
+			Color:   n.NoteAttributes["color"],
+			BgColor: n.NoteAttributes["bgcolor"],
+		}
+		if t, c := e.attachment(n); t != nil {
+			node.Table = t.Name
+			if c != nil {
+				node.Port = port(t, *c)
+			}
+		}
+		nodes = append(nodes, node)
+	}
+	for _, name := range e.TableNames {
+		t, ok := e.Tables[name]
+		if !ok {
+			continue
+		}
+		if text := t.TableAttributes["note"]; text != "" {
+			nodes = append(nodes, NoteNode{Name: t.Name + "__note", Text: text, Table: t.Name})
+		}
+		for _, c := range t.Columns {
+			if text := c.ColumnAttributes["note"]; text != "" {
+				nodes = append(nodes, NoteNode{
+					Name:  t.Name + "__" + c.Port() + "__note",
+					Text:  text,
+					Table: t.Name,
+					Port:  port(t, c),
+				})
+			}
+		}
+	}
+	return nodes
+}
+
+// LinkedPorts returns the ports of the columns an enum or a note is linked
+// to, by table. Only these columns are given a port.
+func (e *Erd) LinkedPorts() map[string]map[string]bool {
+	ports := map[string]map[string]bool{}
+	link := func(table, port string) {
+		if port == "" {
+			return
+		}
+		if ports[table] == nil {
+			ports[table] = map[string]bool{}
+		}
+		ports[table][port] = true
+	}
+	for _, l := range e.EnumLinks() {
+		link(l.Table, l.Port)
+	}
+	for _, n := range e.NoteNodes() {
+		link(n.Table, n.Port)
+	}
+	return ports
+}
+
+// Lines returns the lines of the text of the note
+func (n NoteNode) Lines() []string {
+	return strings.Split(strings.TrimRight(n.Text, "\n"), "\n")
+}
+
+// colorValue returns the current value, looked up in the color palette
+// when the key is a color
+func (e *Erd) colorValue() string {
 	if strings.Contains(e.key, "color") {
-		v, ok := e.Colors[e.value]
-		if ok {
-			val = v
+		if v, ok := e.Colors[e.value]; ok {
+			return v
 		}
 	}
-	table.TableAttributes[e.key] = val
+	return e.value
 }
 
 // AddColorDefine stores the named color palette
@@ -124,6 +572,10 @@ func (e *Erd) AddColorDefine() {
 
 // AddColumn adds a column to the EDR
 func (e *Erd) AddColumn(text string) {
+	if r := e.CurrentRelationship; r != nil {
+		r.Columns = append(r.Columns, Column{Title: text, ColumnAttributes: map[string]string{}})
+		return
+	}
 	if e.CurrentTableName == "" {
 		e.Error(errors.New("Invalid State"))
 	}
@@ -133,18 +585,99 @@ func (e *Erd) AddColumn(text string) {
 	table.CurrentColumnID = len(table.Columns) - 1
 }
 
-// AddColumnKeyValue adds a key value pair to the column attributes
-func (e *Erd) AddColumnKeyValue() {
+// currentColumn returns the last column of the current table or relationship
+func (e *Erd) currentColumn() *Column {
+	if r := e.CurrentRelationship; r != nil {
+		return &r.Columns[len(r.Columns)-1]
+	}
 	table := e.Tables[e.CurrentTableName]
-	column := table.Columns[table.CurrentColumnID]
+	return &table.Columns[table.CurrentColumnID]
+}
+
+// AddColumnKeyValue adds a key value pair to the column attributes. The
+// keys type, null, default, unique and check set the definition of the
+// column instead.
+func (e *Erd) AddColumnKeyValue() {
+	column := e.currentColumn()
 	if column.ColumnAttributes == nil {
 		column.ColumnAttributes = map[string]string{}
 	}
-	column.ColumnAttributes[e.key] = e.value
+
+	val := e.colorValue()
+	switch e.key {
+	case "type":
+		column.Type = val
+	case "null":
+		column.NotNull = !isTrue(val)
+	case "default":
+		column.Default = val
+	case "unique":
+		column.Unique = isTrue(val)
+	case "check":
+		column.Check = val
+	default:
+		column.ColumnAttributes[e.key] = val
+	}
 	e.key = ""
 	e.value = ""
 }
 
+// SetColumnType sets the type of the current column
+func (e *Erd) SetColumnType(text string) {
+	e.currentColumn().Type = strings.Join(strings.Fields(text), " ")
+}
+
+// SetColumnNotNull sets whether the current column is not null
+func (e *Erd) SetColumnNotNull(notNull bool) {
+	e.currentColumn().NotNull = notNull
+}
+
+// SetColumnUnique makes the current column unique
+func (e *Erd) SetColumnUnique() {
+	e.currentColumn().Unique = true
+}
+
+// SetColumnDefault sets the default of the current column
+func (e *Erd) SetColumnDefault(text string) {
+	e.currentColumn().Default = text
+}
+
+// SetColumnCheck sets the check constraint of the current column, given
+// in parentheses
+func (e *Erd) SetColumnCheck(text string) {
+	e.currentColumn().Check = strings.TrimSpace(text[1 : len(text)-1])
+}
+
+// AddRelationship starts a relationship declaration
+func (e *Erd) AddRelationship(text string) {
+	r := &Relationship{
+		Name:                   replaceAllIllegal(text) + "__relationship",
+		Title:                  text,
+		RelationshipAttributes: map[string]string{},
+	}
+	e.Relationships = append(e.Relationships, r)
+	e.CurrentRelationship = r
+	e.CurrentTableName = ""
+}
+
+// SetParticipant sets the table of the next participant of the relationship
+func (e *Erd) SetParticipant(text string) {
+	e.CurrentRelationship.Participants = append(e.CurrentRelationship.Participants,
+		Participant{TableName: replaceAllIllegal(text)})
+}
+
+// AddParticipant sets the cardinality of the participant and connects its table
+func (e *Erd) AddParticipant(text string) {
+	participants := e.CurrentRelationship.Participants
+	participants[len(participants)-1].Cardinality = text
+	e.Connect(participants[len(participants)-1].TableName)
+}
+
+// AddRelationshipKeyValue adds a key value pair to the relationship attributes
+func (e *Erd) AddRelationshipKeyValue() {
+	e.CurrentRelationship.RelationshipAttributes[e.key] = e.colorValue()
+}
+
 // SetKey sets the current key
 func (e *Erd) SetKey(text string) {
 	e.key = text
@@ -179,7 +712,18 @@ func (e *Erd) AddRelationKeyValue() {
 	if e.CurrentRelation.RelationAttributes == nil {
 		e.CurrentRelation.RelationAttributes = map[string]string{}
 	}
-	e.CurrentRelation.RelationAttributes[e.key] = e.value
+
+	val := e.colorValue()
+	e.CurrentRelation.RelationAttributes[e.key] = val
+
+	if e.key == "identifying" && isTrue(val) {
+		e.CurrentRelation.Identifying = true
+	}
+}
+
+// SetRelationOperator marks the current relation identifying for ==
+func (e *Erd) SetRelationOperator(text string) {
+	e.CurrentRelation.Identifying = text == "=="
 }
 
 // SetRelationLeft sets the left side of the current relation
@@ -201,7 +745,107 @@ func (e *Erd) SetRelationRight(text string) {
 	e.Connect(name)
 }
 
+// Identifying reports whether any relation is identifying, in which case
+// the others are drawn dashed
+func (e *Erd) Identifying() bool {
+	for _, r := range e.Relations {
+		if r.Identifying {
+			return true
+		}
+	}
+	return false
+}
+
+// Merge adds the tables, relations, enums, notes and colors of another ERD
+// to this one. A table or enum defined in both ERDs is reported as an error.
+// So is a table put in two groups.
+func (e *Erd) Merge(o *Erd) error {
+	for _, name := range o.TableNames {
+		if _, ok := e.Tables[name]; ok {
+			return fmt.Errorf("duplicate table %q", o.Tables[name].Title)
+		}
+	}
+	for _, en := range o.Enums {
+		if e.enum(en.Title) != nil {
+			return fmt.Errorf("duplicate enum %q", en.Title)
+		}
+	}
+
+	if e.Title.Title == "" {
+		e.Title.Title = o.Title.Title
+	}
+	if e.Title.TitleAttributes == nil {
+		e.Title.TitleAttributes = map[string]string{}
+	}
+	for k, v := range o.Title.TitleAttributes {
+		if _, ok := e.Title.TitleAttributes[k]; !ok {
+			e.Title.TitleAttributes[k] = v
+		}
+	}
+	if e.GraphAttributes == nil {
+		e.GraphAttributes = map[string]string{}
+	}
+	for k, v := range o.GraphAttributes {
+		if _, ok := e.GraphAttributes[k]; !ok {
+			e.GraphAttributes[k] = v
+		}
+	}
+	if e.Colors == nil {
+		e.Colors = map[string]string{}
+	}
+	for k, v := range o.Colors {
+		e.Colors[k] = v
+	}
+
+	if e.Tables == nil {
+		e.Tables = map[string]*Table{}
+	}
+	for _, name := range o.TableNames {
+		e.Tables[name] = o.Tables[name]
+		e.TableNames = append(e.TableNames, name)
+	}
+
+	for _, og := range o.Groups {
+		g := e.group(og.Title)
+		for k, v := range og.GroupAttributes {
+			if _, ok := g.GroupAttributes[k]; !ok {
+				g.GroupAttributes[k] = v
+			}
+		}
+		for _, name := range og.TableNames {
+			g.addTable(name)
+		}
+	}
+
+	// relations may refer to tables from the other ERD
+	e.Relations = append(e.Relations, o.Relations...)
+	for _, r := range e.Relations {
+		e.Connect(r.LeftTableName)
+		e.Connect(r.RightTableName)
+	}
+	e.Relationships = append(e.Relationships, o.Relationships...)
+	e.Enums = append(e.Enums, o.Enums...)
+	for _, n := range o.Notes {
+		n.Name = fmt.Sprintf("note__%d", len(e.Notes))
+		e.Notes = append(e.Notes, n)
+	}
+	for _, r := range e.Relationships {
+		for _, p := range r.Participants {
+			e.Connect(p.TableName)
+		}
+	}
+	return e.checkGroups()
+}
+
 func (e *Erd) CalcIsolated() {
+	// supertypes may be defined after their subtypes, or in another file
+	for _, t := range e.Tables {
+		if _, ok := e.Tables[t.Extends]; ok {
+			t.Connect()
+			e.Connect(t.Extends)
+		}
+	}
+	e.Isolations = nil
 	for _, name := range e.TableNames {
 		if table, ok := e.Tables[name]; ok {
 			if !table.Connected {
@@ -2,9 +2,9 @@ package main
 
 import (
 	"bytes"
+	"reflect"
 	"strings"
 	"testing"
-	"text/template"
 )
 
 func TestErd_unquote(t *testing.T) {
@@ -47,13 +47,414 @@ Person *--1 Location
 		t.Fatal()
 	}
 
-	dot, _ := Asset("templates/dot.tmpl")
-	tables, _ := Asset("templates/dot_tables.tmpl")
-	relations, _ := Asset("templates/dot_relations.tmpl")
-	templates := template.Must(template.New("").Funcs(template.FuncMap{"StringsJoin": strings.Join}).Parse(string(dot) + string(tables) + string(relations)))
+	templates := loadTemplates("")
 
 	fd := bytes.NewBufferString("")
-	if err := templates.ExecuteTemplate(fd, "dot", parser.Erd); err != nil {
+	if err := templates.ExecuteTemplate(fd, "dot", &parser.Erd); err != nil {
 		t.Fatal(err)
 	}
 }
+
+func TestErd_Merge(t *testing.T) {
+	a, err := parseErd("[Person]\n*name\n+birth_location_id\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := parseErd("[Location]\n*id\n\nPerson *--1 Location\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	erd := &Erd{}
+	if err := erd.Merge(a); err != nil {
+		t.Fatal(err)
+	}
+	if err := erd.Merge(b); err != nil {
+		t.Fatal(err)
+	}
+	if len(erd.TableNames) != 2 || len(erd.Relations) != 1 {
+		t.Errorf("got: %v tables, %v relations\nwant: 2 tables, 1 relation", len(erd.TableNames), len(erd.Relations))
+	}
+	if !erd.Tables["Person"].Connected {
+		t.Errorf("Person should be connected by a relation from another file")
+	}
+
+	if err := erd.Merge(a); err == nil {
+		t.Errorf("duplicate table should be reported")
+	}
+}
+
+func TestGroups(t *testing.T) {
+	erd, err := parseErd(`
+group "Billing" {bgcolor: "#eee"} { invoice, payment }
+
+[invoice]
+*id
+
+[user] {group: "R&D <core>"}
+*id
+
+[refund] {group: Billing}
+*id
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(erd.Groups) != 2 {
+		t.Fatalf("got: %v groups\nwant: 2", len(erd.Groups))
+	}
+	billing := erd.Groups[0]
+	if billing.Title != "Billing" || billing.GroupAttributes["bgcolor"] != "#eee" {
+		t.Errorf("got: %v %v", billing.Title, billing.GroupAttributes)
+	}
+	if got := strings.Join(billing.TableNames, ","); got != "invoice,payment,refund" {
+		t.Errorf("got: %v\nwant: %v", got, "invoice,payment,refund")
+	}
+	if got := strings.Join(erd.Groups[1].TableNames, ","); got != "user" {
+		t.Errorf("got: %v\nwant: %v", got, "user")
+	}
+
+	var buf bytes.Buffer
+	if err := loadTemplates("").ExecuteTemplate(&buf, "dot", erd); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "subgraph cluster_Billing") {
+		t.Errorf("cluster for Billing not rendered")
+	}
+	if want := `<FONT POINT-SIZE="14">R&amp;D &lt;core&gt;</FONT>`; !strings.Contains(buf.String(), want) {
+		t.Errorf("%q not found in\n%v", want, buf.String())
+	}
+
+	// a theme without a group color leaves the border color to Graphviz
+	erd.theme = &Theme{}
+	buf.Reset()
+	if err := loadTemplates("").ExecuteTemplate(&buf, "dot", erd); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(buf.String(), `color=""`) {
+		t.Errorf("empty color rendered in\n%v", buf.String())
+	}
+
+	if _, err := parseErd("group a {x}\ngroup b {x}\n[x]\n"); err == nil {
+		t.Errorf("a table in two groups should be reported")
+	}
+}
+
+func TestTable_VisibleColumns(t *testing.T) {
+	table := &Table{Columns: []Column{{Title: "*id"}, {Title: "name"}, {Title: "+owner_id"}}}
+
+	tests := []struct {
+		mode string
+		want int
+	}{
+		{"", 3},
+		{"all", 3},
+		{"keys", 2},
+		{"none", 0},
+	}
+	for _, tt := range tests {
+		if got := len(table.VisibleColumns(tt.mode)); got != tt.want {
+			t.Errorf("%v: got: %v\nwant: %v", tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestNotations(t *testing.T) {
+	erd, err := parseErd("[Person]\n*name\n\n[Location]\n*id\n\nPerson *--1 Location\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	erd.CalcIsolated()
+
+	tests := []struct {
+		notation string
+		want     string
+	}{
+		{"crowsfoot", "arrowtail=ocrow,taillabel=<<FONT>0..N</FONT>>"},
+		{"uml", "taillabel=<<FONT>0..*</FONT>>"},
+		{"chen", "relationship_0 [shape=diamond"},
+		{"idef1x", "arrowtail=dot"},
+		{"minmax", "headlabel=<<FONT>(0,N)</FONT>>"},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		if err := loadTemplates(tt.notation).ExecuteTemplate(&buf, "dot", erd); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(buf.String(), tt.want) {
+			t.Errorf("%v: %q not found in\n%v", tt.notation, tt.want, buf.String())
+		}
+	}
+}
+
+func TestEscaping(t *testing.T) {
+	erd, err := parseErd(`title {label: "<b>&"}
+[a<b>] {label: "<b>&", bgcolor: "x\x22y"}
+*id {label: "<b>&", icon: "<b>", color: "x\x22y", bgcolor: "x\x22y"}
+
+[c]
+*id
+
+a<b> 1--* c {label: "<b>&", headlabel: "<b>", taillabel: "<b>", color: "x\x22y\\"}
+enum e {"<b>&"}
+note "<b>" {color: "x\x22y"}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	erd.CalcIsolated()
+
+	for _, notation := range []string{"crowsfoot", "uml", "chen", "idef1x", "minmax"} {
+		var buf bytes.Buffer
+		if err := loadTemplates(notation).ExecuteTemplate(&buf, "dot", erd); err != nil {
+			t.Fatal(err)
+		}
+		for _, s := range []string{"<b>", `x"y`} {
+			if strings.Contains(buf.String(), s) {
+				t.Errorf("%v: %q not escaped in\n%v", notation, s, buf.String())
+			}
+		}
+		for _, want := range []string{"&lt;b&gt;&amp;", `color="x\"y\\"`} {
+			if !strings.Contains(buf.String(), want) {
+				t.Errorf("%v: %q not found in\n%v", notation, want, buf.String())
+			}
+		}
+	}
+}
+
+func TestColumnStyle(t *testing.T) {
+	erd, err := parseErd(`colors {pii: "#c62828"}
+[user]
+*id {bold: true, icon: "#"}
+email {color: pii, bgcolor: "#fff0f0", italic: yes}
+legacy {strike: true}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := erd.Tables["user"].Columns[1].ColumnAttributes["color"]; got != "#c62828" {
+		t.Errorf("got: %v\nwant: %v", got, "#c62828")
+	}
+
+	var buf bytes.Buffer
+	if err := loadTemplates("").ExecuteTemplate(&buf, "dot", erd); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`<FONT POINT-SIZE="12">#&nbsp;<B>*id</B></FONT>`,
+		`<TD ALIGN="LEFT" BGCOLOR="#fff0f0"><FONT POINT-SIZE="12" COLOR="#c62828"><I>email</I></FONT>`,
+		`<FONT POINT-SIZE="12"><S>legacy</S></FONT>`,
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("%q not found in\n%v", want, buf.String())
+		}
+	}
+}
+
+func TestRelationStyle(t *testing.T) {
+	erd, err := parseErd(`colors {fk: "#1565c0"}
+[a]
+[b]
+a 1--* b {color: fk, style: dashed, penwidth: 2, headlabel: "many", constraint: false}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		notation string
+		want     string
+	}{
+		{"crowsfoot", `color="#1565c0",style="dashed",penwidth="2",constraint=false,headlabel=<<FONT>many</FONT>>]`},
+		{"chen", `relationship_0 -- b [dir=none,label=<<FONT>many</FONT>>,color="#1565c0",style="dashed",penwidth="2",constraint=false]`},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		if err := loadTemplates(tt.notation).ExecuteTemplate(&buf, "dot", erd); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(buf.String(), tt.want) {
+			t.Errorf("%v: %q not found in\n%v", tt.notation, tt.want, buf.String())
+		}
+	}
+}
+
+func TestRelationships(t *testing.T) {
+	erd, err := parseErd(`[Student]
+[Course]
+[Term]
+<enrollment> Student *, Course *, Term 1 {label: "enrolls"}
+  grade
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(erd.Relationships) != 1 {
+		t.Fatalf("got: %v\nwant: %v", len(erd.Relationships), 1)
+	}
+	r := erd.Relationships[0]
+	want := []Participant{{"Student", "*"}, {"Course", "*"}, {"Term", "1"}}
+	if !reflect.DeepEqual(r.Participants, want) {
+		t.Errorf("got: %v\nwant: %v", r.Participants, want)
+	}
+	if len(r.Columns) != 1 || r.Columns[0].Title != "grade" {
+		t.Errorf("got: %v\nwant: %v", r.Columns, "[grade]")
+	}
+	if !erd.Tables["Term"].Connected {
+		t.Errorf("got: %v\nwant: %v", false, true)
+	}
+
+	var buf bytes.Buffer
+	if err := loadTemplates("").ExecuteTemplate(&buf, "dot", erd); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`enrollment__relationship [shape=diamond,margin="0.05,0.05",label=<enrolls<BR/>`,
+		`Student -- enrollment__relationship [dir=none,label=<<FONT>(0,N)</FONT>>];`,
+		`Term -- enrollment__relationship [dir=none,label=<<FONT>(1,1)</FONT>>];`,
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("%q not found in\n%v", want, buf.String())
+		}
+	}
+}
+
+func TestSubtypes(t *testing.T) {
+	erd, err := parseErd(`[Person] extends Party {disjoint: true, complete: false}
+[Organization] extends Party
+[Party]
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if erd.Tables["Person"].Extends != "Party" {
+		t.Errorf("got: %v\nwant: %v", erd.Tables["Person"].Extends, "Party")
+	}
+	erd.CalcIsolated()
+	if len(erd.Isolations) != 0 {
+		t.Errorf("got: %v\nwant: %v", erd.Isolations, "[]")
+	}
+
+	var buf bytes.Buffer
+	if err := loadTemplates("").ExecuteTemplate(&buf, "dot", erd); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`Party -- Organization [dir=back,arrowtail=empty,arrowsize=1.5];`,
+		`Party -- Person [dir=back,arrowtail=empty,arrowsize=1.5,label=<<FONT>{disjoint, incomplete}</FONT>>];`,
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("%q not found in\n%v", want, buf.String())
+		}
+	}
+}
+
+func TestEnums(t *testing.T) {
+	erd, err := parseErd(`enum player_pos {QB, RB, "not applicable"}
+[player]
+*id
+position {type: player_pos}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"QB", "RB", "not applicable"}
+	if !reflect.DeepEqual(erd.Enums[0].Values, want) {
+		t.Errorf("got: %v\nwant: %v", erd.Enums[0].Values, want)
+	}
+
+	tests := []struct {
+		mode string
+		want string
+	}{
+		{"all", `player:position -- player_pos__enum [dir=none,style=dotted,color="grey60"];`},
+		{"keys", `player -- player_pos__enum [dir=none,style=dotted,color="grey60"];`},
+	}
+	for _, tt := range tests {
+		erd.ColumnMode = tt.mode
+		var buf bytes.Buffer
+		if err := loadTemplates("").ExecuteTemplate(&buf, "dot", erd); err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range []string{`player_pos__enum [shape=note`, tt.want} {
+			if !strings.Contains(buf.String(), want) {
+				t.Errorf("%s: %q not found in\n%v", tt.mode, want, buf.String())
+			}
+		}
+	}
+}
+
+func TestNotes(t *testing.T) {
+	erd, err := parseErd(`[player] {note: "Players are\nregistered yearly"}
+*id
+name {note: "full & legal name"}
+note "drafted players only" {attach: player.id, bgcolor: lightyellow}
+note "free floating"
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(erd.Notes) != 2 || erd.Notes[0].Text != "drafted players only" {
+		t.Fatalf("got: %+v\nwant: %v", erd.Notes, "drafted players only")
+	}
+
+	tests := []struct {
+		mode string
+		want []string
+	}{
+		{"all", []string{
+			`note__0 [shape=note,margin="0.1,0.05",fontsize=10,label=<drafted players only<BR ALIGN="LEFT"/>>,color="grey60",style=filled,fillcolor="lightyellow"];`,
+			`player:id -- note__0 [dir=none,style=dotted,color="grey60"];`,
+			`note__1 [shape=note`,
+			`label=<Players are<BR ALIGN="LEFT"/>registered yearly<BR ALIGN="LEFT"/>>`,
+			`player -- player__note [dir=none`,
+			`label=<full &amp; legal name<BR ALIGN="LEFT"/>>`,
+			`player:name -- player__name__note [dir=none`,
+		}},
+		{"keys", []string{
+			`player:id -- note__0 [dir=none`,
+			`player -- player__name__note [dir=none`,
+		}},
+	}
+	for _, tt := range tests {
+		erd.ColumnMode = tt.mode
+		var buf bytes.Buffer
+		if err := loadTemplates("").ExecuteTemplate(&buf, "dot", erd); err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(buf.String(), want) {
+				t.Errorf("%s: %q not found in\n%v", tt.mode, want, buf.String())
+			}
+		}
+		if strings.Contains(buf.String(), "note__1 --") {
+			t.Errorf("%s: free floating note attached in\n%v", tt.mode, buf.String())
+		}
+	}
+}
+
+func TestColumnDefinition(t *testing.T) {
+	erd, err := parseErd(`[player]
+*player_id varchar(36) NOT NULL default 'x' unique {label: id}
+born timestamp with time zone null check (born > '1900-01-01')
+team {type: "char(3)", null: false, default: "'SF'", unique: true, check: "team <> ''"}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := erd.Tables["player"].Columns
+	want := []Column{
+		{Title: "*player_id", Type: "varchar(36)", NotNull: true, Default: "'x'", Unique: true,
+			ColumnAttributes: map[string]string{"label": "id"}},
+		{Title: "born", Type: "timestamp with time zone", Check: "born > '1900-01-01'",
+			ColumnAttributes: map[string]string{}},
+		{Title: "team", Type: "char(3)", NotNull: true, Default: "'SF'", Unique: true, Check: "team <> ''",
+			ColumnAttributes: map[string]string{}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %+v\nwant: %+v", got, want)
+	}
+	if label := got[0].TypeLabel(); label != "varchar(36) not null unique" {
+		t.Errorf("got: %v\nwant: %v", label, "varchar(36) not null unique")
+	}
+}
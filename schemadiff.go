@@ -0,0 +1,846 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DiffCommand compares two schemas
+type DiffCommand struct {
+	Format string `long:"format" default:"text" choice:"text" choice:"json" choice:"markdown" description:"format of the report."`
+	Render bool   `long:"render" description:"draw the new schema with the changes highlighted instead of reporting them."`
+	Output string `short:"o" long:"output" description:"file of the --render diagram, its extension gives the Graphviz format."`
+}
+
+var diffCommand DiffCommand
+
+// Kinds of changes
+const (
+	ChangeAdded   = "added"
+	ChangeRemoved = "removed"
+	ChangeRenamed = "renamed"
+	ChangeChanged = "changed"
+)
+
+// AttributeChange is a changed attribute; Old or New is empty when the
+// attribute was added or removed
+type AttributeChange struct {
+	Key string `json:"key"`
+	Old string `json:"old,omitempty"`
+	New string `json:"new,omitempty"`
+}
+
+// ColumnChange is a change of a column, identified by its name without the
+// key markers
+type ColumnChange struct {
+	Kind       string            `json:"kind"`
+	Name       string            `json:"name"`
+	OldKey     string            `json:"old_key,omitempty"`
+	NewKey     string            `json:"new_key,omitempty"`
+	Attributes []AttributeChange `json:"attributes,omitempty"`
+}
+
+// TableChange is a change of a table. A table is renamed when a removed
+// and an added table have the same columns, at least two.
+type TableChange struct {
+	Kind       string            `json:"kind"`
+	Name       string            `json:"name"`
+	OldName    string            `json:"old_name,omitempty"`
+	Attributes []AttributeChange `json:"attributes,omitempty"`
+	Columns    []ColumnChange    `json:"columns,omitempty"`
+}
+
+// RelationChange is a change of the relations between two tables
+type RelationChange struct {
+	Kind       string            `json:"kind"`
+	Left       string            `json:"left"`
+	Right      string            `json:"right"`
+	Old        string            `json:"old,omitempty"` // cardinalities, like 1--*
+	New        string            `json:"new,omitempty"`
+	Attributes []AttributeChange `json:"attributes,omitempty"`
+
+	oldIndex, newIndex int // in the Relations of the schemas, -1 if none
+}
+
+// EnumChange is a change of an enum, with the values added to and removed
+// from it when it is changed
+type EnumChange struct {
+	Kind    string   `json:"kind"`
+	Name    string   `json:"name"`
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+}
+
+// GroupChange is a change of a group, with the tables put in and taken out
+// of it when it is changed
+type GroupChange struct {
+	Kind       string            `json:"kind"`
+	Name       string            `json:"name"`
+	Attributes []AttributeChange `json:"attributes,omitempty"`
+	Added      []string          `json:"added,omitempty"`
+	Removed    []string          `json:"removed,omitempty"`
+}
+
+// NoteChange is a change of a note statement. Notes are told apart by their
+// text, so a note whose text changes is removed and added.
+type NoteChange struct {
+	Kind       string            `json:"kind"`
+	Text       string            `json:"text"`
+	Attributes []AttributeChange `json:"attributes,omitempty"`
+}
+
+// SchemaDiff is the difference between two schemas
+type SchemaDiff struct {
+	Tables    []TableChange    `json:"tables"`
+	Relations []RelationChange `json:"relations"`
+	Enums     []EnumChange     `json:"enums"`
+	Groups    []GroupChange    `json:"groups"`
+	Notes     []NoteChange     `json:"notes"`
+}
+
+// Empty reports whether the schemas are the same
+func (d *SchemaDiff) Empty() bool {
+	return len(d.Tables) == 0 && len(d.Relations) == 0 &&
+		len(d.Enums) == 0 && len(d.Groups) == 0 && len(d.Notes) == 0
+}
+
+// Execute prints the differences between the two schemas and exits with
+// status 1 if there are any
+func (c *DiffCommand) Execute(args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("usage: diff OLD NEW")
+	}
+	var erds [2]*Erd
+	for i, pattern := range args {
+		inputs, err := readInputs([]string{pattern})
+		if err != nil {
+			return err
+		}
+		erds[i], err = load(inputs)
+		if err != nil {
+			return err
+		}
+	}
+
+	d := DiffSchemas(erds[0], erds[1])
+	var err error
+	if c.Render {
+		err = c.render(DiffModel(erds[0], erds[1], d))
+	} else {
+		err = writeDiff(os.Stdout, d, c.Format)
+	}
+	if err != nil {
+		return err
+	}
+	if !d.Empty() {
+		return exitStatus(1)
+	}
+	return nil
+}
+
+// render draws the annotated model to the output file, or stdout
+func (c *DiffCommand) render(erd *Erd) error {
+	erd, err := filtered(erd)
+	if err != nil {
+		return err
+	}
+	t, err := templates(opts.Notation, opts.TemplateDir, opts.Templates)
+	if err != nil {
+		return err
+	}
+	format := opts.OutFormat
+	if ext := strings.TrimPrefix(filepath.Ext(c.Output), "."); format == "" && ext != "dot" {
+		format = ext
+	}
+
+	w := os.Stdout
+	if c.Output != "" {
+		w, err = os.Create(c.Output)
+		if err != nil {
+			return err
+		}
+		defer w.Close()
+	}
+	return renderFormat(context.Background(), erd, t, format, w)
+}
+
+// columnKey describes the key markers of a column title
+func columnKey(title string) string {
+	var keys []string
+	markers := title[:len(title)-len(strings.TrimLeft(title, "*+"))]
+	if strings.Contains(markers, "*") {
+		keys = append(keys, "primary")
+	}
+	if strings.Contains(markers, "+") {
+		keys = append(keys, "foreign")
+	}
+	return strings.Join(keys, ", ")
+}
+
+// columnName returns the name of a column without its key markers
+func columnName(title string) string {
+	return strings.TrimLeft(title, "*+")
+}
+
+// tableAttributes returns the attributes of a table to compare, its
+// supertype included as extends
+func tableAttributes(t *Table) map[string]string {
+	if t.Extends == "" {
+		return t.TableAttributes
+	}
+	attrs := map[string]string{"extends": t.Extends}
+	for k, v := range t.TableAttributes {
+		attrs[k] = v
+	}
+	return attrs
+}
+
+// columnAttributes returns the attributes of a column to compare, its
+// definition included under the keys of the attributes setting it
+func columnAttributes(c Column) map[string]string {
+	attrs := map[string]string{}
+	for k, v := range c.ColumnAttributes {
+		attrs[k] = v
+	}
+	add := func(key, value string) {
+		if value != "" {
+			attrs[key] = value
+		}
+	}
+	add("type", c.Type)
+	add("default", c.Default)
+	add("check", c.Check)
+	if c.NotNull {
+		attrs["null"] = "false"
+	}
+	if c.Unique {
+		attrs["unique"] = "true"
+	}
+	return attrs
+}
+
+// diffAttributes compares two sets of attributes, by key
+func diffAttributes(old, new map[string]string) []AttributeChange {
+	keys := map[string]bool{}
+	for k := range old {
+		keys[k] = true
+	}
+	for k := range new {
+		keys[k] = true
+	}
+	var sorted []string
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+
+	var changes []AttributeChange
+	for _, k := range sorted {
+		o, inOld := old[k]
+		n, inNew := new[k]
+		if inOld != inNew || o != n {
+			changes = append(changes, AttributeChange{Key: k, Old: o, New: n})
+		}
+	}
+	return changes
+}
+
+// diffColumns compares the columns of two versions of a table
+func diffColumns(old, new *Table) []ColumnChange {
+	oldColumns := map[string]Column{}
+	for _, c := range old.Columns {
+		oldColumns[columnName(c.Title)] = c
+	}
+	newColumns := map[string]bool{}
+
+	var changes []ColumnChange
+	for _, c := range new.Columns {
+		name := columnName(c.Title)
+		newColumns[name] = true
+		o, ok := oldColumns[name]
+		if !ok {
+			changes = append(changes, ColumnChange{Kind: ChangeAdded, Name: name, NewKey: columnKey(c.Title)})
+			continue
+		}
+		change := ColumnChange{Kind: ChangeChanged, Name: name}
+		if oldKey, newKey := columnKey(o.Title), columnKey(c.Title); oldKey != newKey {
+			change.OldKey, change.NewKey = oldKey, newKey
+		}
+		change.Attributes = diffAttributes(columnAttributes(o), columnAttributes(c))
+		if change.OldKey != change.NewKey || len(change.Attributes) > 0 {
+			changes = append(changes, change)
+		}
+	}
+	for _, c := range old.Columns {
+		if name := columnName(c.Title); !newColumns[name] {
+			changes = append(changes, ColumnChange{Kind: ChangeRemoved, Name: name, OldKey: columnKey(c.Title)})
+		}
+	}
+	return changes
+}
+
+// sameColumns reports whether two tables have the same column names. Tables
+// with a single column, like a lone id, are too alike to tell.
+func sameColumns(a, b *Table) bool {
+	if len(a.Columns) < 2 || len(a.Columns) != len(b.Columns) {
+		return false
+	}
+	names := map[string]bool{}
+	for _, c := range a.Columns {
+		names[columnName(c.Title)] = true
+	}
+	for _, c := range b.Columns {
+		if !names[columnName(c.Title)] {
+			return false
+		}
+	}
+	return true
+}
+
+// renames pairs the tables removed from old with the added tables of new
+// having the same columns, from new names to old names
+func renames(old, new *Erd) map[string]string {
+	renamed := map[string]string{}
+	taken := map[string]bool{}
+	for _, n := range new.TableNames {
+		if _, ok := old.Tables[n]; ok {
+			continue
+		}
+		for _, o := range old.TableNames {
+			if _, ok := new.Tables[o]; ok || taken[o] {
+				continue
+			}
+			if sameColumns(old.Tables[o], new.Tables[n]) {
+				renamed[n] = o
+				taken[o] = true
+				break
+			}
+		}
+	}
+	return renamed
+}
+
+// relationEnd is a relation in the canonical direction, its tables in
+// alphabetical order
+type relationEnd struct {
+	left, right string
+	cardinality string // like 1--*, or 1==* if identifying
+	attributes  map[string]string
+	flipped     bool // written the other way round
+	index       int  // in the Relations of the schema
+}
+
+func flipCardinality(c string) string {
+	if c == "" {
+		return ""
+	}
+	return c[len(c)-1:] + c[1:len(c)-1] + c[:1]
+}
+
+// relationEnds returns the relations in the canonical direction by pair of
+// tables, renaming the tables with rename, and the pairs in the order of
+// the source
+func relationEnds(e *Erd, rename func(string) string) (map[[2]string][]relationEnd, [][2]string) {
+	ends := map[[2]string][]relationEnd{}
+	var keys [][2]string
+	for i, r := range e.Relations {
+		end := relationEnd{
+			index:       i,
+			left:        rename(r.LeftTableName),
+			right:       rename(r.RightTableName),
+			cardinality: r.LeftCardinality + r.Operator() + r.RightCardinality,
+			attributes:  r.RelationAttributes,
+		}
+		if end.right < end.left {
+			end.left, end.right = end.right, end.left
+			end.cardinality = flipCardinality(end.cardinality)
+			end.flipped = true
+		}
+		key := [2]string{end.left, end.right}
+		if _, ok := ends[key]; !ok {
+			keys = append(keys, key)
+		}
+		ends[key] = append(ends[key], end)
+	}
+	return ends, keys
+}
+
+// enumChange returns the values added to and removed from an enum
+func enumChange(old, new *Enum) (added, removed []string) {
+	has := func(values []string, v string) bool {
+		for _, x := range values {
+			if x == v {
+				return true
+			}
+		}
+		return false
+	}
+	for _, v := range new.Values {
+		if !has(old.Values, v) {
+			added = append(added, v)
+		}
+	}
+	for _, v := range old.Values {
+		if !has(new.Values, v) {
+			removed = append(removed, v)
+		}
+	}
+	return added, removed
+}
+
+// diffEnums compares the enums of two schemas, by title
+func diffEnums(old, new *Erd) []EnumChange {
+	changes := []EnumChange{}
+	for _, en := range new.Enums {
+		o := old.enum(en.Title)
+		if o == nil {
+			changes = append(changes, EnumChange{Kind: ChangeAdded, Name: en.Title})
+			continue
+		}
+		added, removed := enumChange(o, en)
+		if len(added) > 0 || len(removed) > 0 {
+			changes = append(changes, EnumChange{Kind: ChangeChanged, Name: en.Title, Added: added, Removed: removed})
+		}
+	}
+	for _, en := range old.Enums {
+		if new.enum(en.Title) == nil {
+			changes = append(changes, EnumChange{Kind: ChangeRemoved, Name: en.Title})
+		}
+	}
+	return changes
+}
+
+// diffGroups compares the groups of two schemas, by name. The tables of old
+// are renamed with rename and the tables named with title.
+func diffGroups(old, new *Erd, rename, title func(string) string) []GroupChange {
+	oldGroups := map[string]*Group{}
+	for _, g := range old.Groups {
+		oldGroups[g.Name] = g
+	}
+	newGroups := map[string]bool{}
+
+	changes := []GroupChange{}
+	for _, g := range new.Groups {
+		newGroups[g.Name] = true
+		o, ok := oldGroups[g.Name]
+		if !ok {
+			changes = append(changes, GroupChange{Kind: ChangeAdded, Name: g.Title})
+			continue
+		}
+		change := GroupChange{
+			Kind:       ChangeChanged,
+			Name:       g.Title,
+			Attributes: diffAttributes(o.GroupAttributes, g.GroupAttributes),
+		}
+		members := map[string]bool{}
+		for _, name := range o.TableNames {
+			members[rename(name)] = true
+		}
+		for _, name := range g.TableNames {
+			if !members[name] {
+				change.Added = append(change.Added, title(name))
+			}
+			delete(members, name)
+		}
+		for _, name := range o.TableNames {
+			if members[rename(name)] {
+				change.Removed = append(change.Removed, title(rename(name)))
+			}
+		}
+		if len(change.Attributes) > 0 || len(change.Added) > 0 || len(change.Removed) > 0 {
+			changes = append(changes, change)
+		}
+	}
+	for _, g := range old.Groups {
+		if !newGroups[g.Name] {
+			changes = append(changes, GroupChange{Kind: ChangeRemoved, Name: g.Title})
+		}
+	}
+	return changes
+}
+
+// diffNotes compares the note statements of two schemas, pairing the notes
+// of the same text in order
+func diffNotes(old, new *Erd) []NoteChange {
+	oldNotes := map[string][]*Note{}
+	for _, n := range old.Notes {
+		oldNotes[n.Text] = append(oldNotes[n.Text], n)
+	}
+	paired := map[string]int{} // by text
+
+	changes := []NoteChange{}
+	for _, n := range new.Notes {
+		i := paired[n.Text]
+		if i >= len(oldNotes[n.Text]) {
+			changes = append(changes, NoteChange{Kind: ChangeAdded, Text: n.Text})
+			continue
+		}
+		paired[n.Text]++
+		attrs := diffAttributes(oldNotes[n.Text][i].NoteAttributes, n.NoteAttributes)
+		if len(attrs) > 0 {
+			changes = append(changes, NoteChange{Kind: ChangeChanged, Text: n.Text, Attributes: attrs})
+		}
+	}
+	for _, n := range old.Notes {
+		if paired[n.Text] > 0 {
+			paired[n.Text]--
+			continue
+		}
+		changes = append(changes, NoteChange{Kind: ChangeRemoved, Text: n.Text})
+	}
+	return changes
+}
+
+// DiffSchemas compares two schemas
+func DiffSchemas(old, new *Erd) *SchemaDiff {
+	d := &SchemaDiff{Tables: []TableChange{}, Relations: []RelationChange{}}
+	renamed := renames(old, new)
+	// title names a table by its title, in new or else in old
+	title := func(name string) string {
+		if t, ok := new.Tables[name]; ok {
+			return t.Title
+		}
+		if t, ok := old.Tables[name]; ok {
+			return t.Title
+		}
+		return name
+	}
+
+	for _, name := range new.TableNames {
+		n := new.Tables[name]
+		oldName, isRenamed := renamed[name]
+		if !isRenamed {
+			oldName = name
+		}
+		o, ok := old.Tables[oldName]
+		if !ok {
+			d.Tables = append(d.Tables, TableChange{Kind: ChangeAdded, Name: n.Title})
+			continue
+		}
+		change := TableChange{
+			Kind:       ChangeChanged,
+			Name:       n.Title,
+			Attributes: diffAttributes(tableAttributes(o), tableAttributes(n)),
+			Columns:    diffColumns(o, n),
+		}
+		if isRenamed {
+			change.Kind = ChangeRenamed
+			change.OldName = o.Title
+		}
+		if isRenamed || len(change.Attributes) > 0 || len(change.Columns) > 0 {
+			d.Tables = append(d.Tables, change)
+		}
+	}
+	oldNames := map[string]bool{}
+	for _, o := range renamed {
+		oldNames[o] = true
+	}
+	for _, name := range old.TableNames {
+		if _, ok := new.Tables[name]; !ok && !oldNames[name] {
+			d.Tables = append(d.Tables, TableChange{Kind: ChangeRemoved, Name: old.Tables[name].Title})
+		}
+	}
+
+	// relations are compared with the new names of the renamed tables
+	oldToNew := map[string]string{}
+	for n, o := range renamed {
+		oldToNew[o] = n
+	}
+	rename := func(name string) string {
+		if n, ok := oldToNew[name]; ok {
+			return n
+		}
+		return name
+	}
+	oldEnds, oldKeys := relationEnds(old, rename)
+	newEnds, newKeys := relationEnds(new, func(name string) string { return name })
+
+	// relation describes a relation as it is written, with the cardinalities
+	// of the canonical direction
+	relation := func(kind string, end relationEnd, old, new string) RelationChange {
+		c := RelationChange{Kind: kind, Left: title(end.left), Right: title(end.right), Old: old, New: new, oldIndex: -1, newIndex: -1}
+		if end.flipped {
+			c.Left, c.Right = c.Right, c.Left
+			c.Old, c.New = flipCardinality(old), flipCardinality(new)
+		}
+		return c
+	}
+	seen := map[[2]string]bool{}
+	for _, key := range append(newKeys, oldKeys...) {
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		olds, news := oldEnds[key], newEnds[key]
+		for i, n := range news {
+			if i >= len(olds) {
+				c := relation(ChangeAdded, n, "", n.cardinality)
+				c.newIndex = n.index
+				d.Relations = append(d.Relations, c)
+				continue
+			}
+			o := olds[i]
+			c := relation(ChangeChanged, n, "", "")
+			if o.cardinality != n.cardinality {
+				c = relation(ChangeChanged, n, o.cardinality, n.cardinality)
+			}
+			c.oldIndex, c.newIndex = o.index, n.index
+			c.Attributes = diffAttributes(o.attributes, n.attributes)
+			if c.Old != c.New || len(c.Attributes) > 0 {
+				d.Relations = append(d.Relations, c)
+			}
+		}
+		for i := len(news); i < len(olds); i++ {
+			c := relation(ChangeRemoved, olds[i], olds[i].cardinality, "")
+			c.oldIndex = olds[i].index
+			d.Relations = append(d.Relations, c)
+		}
+	}
+
+	d.Enums = diffEnums(old, new)
+	d.Groups = diffGroups(old, new, rename, title)
+	d.Notes = diffNotes(old, new)
+	return d
+}
+
+// writeDiff prints the differences as text, json or markdown
+func writeDiff(w io.Writer, d *SchemaDiff, format string) error {
+	switch format {
+	case "json":
+		body, err := json.MarshalIndent(d, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", body)
+		return err
+	case "markdown":
+		_, err := io.WriteString(w, markdownDiff(d))
+		return err
+	}
+	_, err := io.WriteString(w, textDiff(d))
+	return err
+}
+
+var changeSigns = map[string]string{
+	ChangeAdded:   "+",
+	ChangeRemoved: "-",
+	ChangeRenamed: "~",
+	ChangeChanged: "~",
+}
+
+// describeAttribute describes an attribute change, quoting the values
+func describeAttribute(a AttributeChange) string {
+	switch {
+	case a.Old == "":
+		return fmt.Sprintf("%s %q added", a.Key, a.New)
+	case a.New == "":
+		return fmt.Sprintf("%s %q removed", a.Key, a.Old)
+	}
+	return fmt.Sprintf("%s %q -> %q", a.Key, a.Old, a.New)
+}
+
+// describeKey describes a key change of a column
+func describeKey(c ColumnChange) string {
+	none := func(key string) string {
+		if key == "" {
+			return "none"
+		}
+		return key
+	}
+	return fmt.Sprintf("key %s -> %s", none(c.OldKey), none(c.NewKey))
+}
+
+// describeRelation describes the cardinalities of a relation change
+func describeRelation(r RelationChange) string {
+	switch r.Kind {
+	case ChangeAdded:
+		return fmt.Sprintf("%s %s %s", r.Left, r.New, r.Right)
+	case ChangeRemoved:
+		return fmt.Sprintf("%s %s %s", r.Left, r.Old, r.Right)
+	}
+	if r.Old != r.New {
+		return fmt.Sprintf("%s %s %s -> %s %s %s", r.Left, r.Old, r.Right, r.Left, r.New, r.Right)
+	}
+	return fmt.Sprintf("%s -- %s", r.Left, r.Right)
+}
+
+// enumDetails lists the values added to and removed from an enum
+func enumDetails(e EnumChange) []string {
+	var details []string
+	for _, v := range e.Added {
+		details = append(details, fmt.Sprintf("value %q added", v))
+	}
+	for _, v := range e.Removed {
+		details = append(details, fmt.Sprintf("value %q removed", v))
+	}
+	return details
+}
+
+// groupDetails lists the tables put in and taken out of a group, and its
+// attribute changes
+func groupDetails(g GroupChange) []string {
+	var details []string
+	for _, name := range g.Added {
+		details = append(details, "table "+name+" added")
+	}
+	for _, name := range g.Removed {
+		details = append(details, "table "+name+" removed")
+	}
+	for _, a := range g.Attributes {
+		details = append(details, describeAttribute(a))
+	}
+	return details
+}
+
+// columnDetails lists the key and attribute changes of a column
+func columnDetails(c ColumnChange) []string {
+	var details []string
+	if c.Kind == ChangeChanged && c.OldKey != c.NewKey {
+		details = append(details, describeKey(c))
+	}
+	for _, a := range c.Attributes {
+		details = append(details, describeAttribute(a))
+	}
+	return details
+}
+
+func textDiff(d *SchemaDiff) string {
+	var sb strings.Builder
+	for _, t := range d.Tables {
+		line := changeSigns[t.Kind] + " table " + t.Name
+		if t.Kind == ChangeRenamed {
+			line += " (renamed from " + t.OldName + ")"
+		}
+		sb.WriteString(line + "\n")
+		for _, a := range t.Attributes {
+			sb.WriteString("    ~ " + describeAttribute(a) + "\n")
+		}
+		for _, c := range t.Columns {
+			line := "    " + changeSigns[c.Kind] + " column " + c.Name
+			if details := columnDetails(c); len(details) > 0 {
+				line += ": " + strings.Join(details, ", ")
+			}
+			sb.WriteString(line + "\n")
+		}
+	}
+	for _, r := range d.Relations {
+		line := changeSigns[r.Kind] + " relation " + describeRelation(r)
+		var details []string
+		for _, a := range r.Attributes {
+			details = append(details, describeAttribute(a))
+		}
+		if len(details) > 0 {
+			line += ": " + strings.Join(details, ", ")
+		}
+		sb.WriteString(line + "\n")
+	}
+	for _, e := range d.Enums {
+		line := changeSigns[e.Kind] + " enum " + e.Name
+		if details := enumDetails(e); len(details) > 0 {
+			line += ": " + strings.Join(details, ", ")
+		}
+		sb.WriteString(line + "\n")
+	}
+	for _, g := range d.Groups {
+		line := changeSigns[g.Kind] + " group " + g.Name
+		if details := groupDetails(g); len(details) > 0 {
+			line += ": " + strings.Join(details, ", ")
+		}
+		sb.WriteString(line + "\n")
+	}
+	for _, n := range d.Notes {
+		line := changeSigns[n.Kind] + " note " + strconv.Quote(n.Text)
+		var details []string
+		for _, a := range n.Attributes {
+			details = append(details, describeAttribute(a))
+		}
+		if len(details) > 0 {
+			line += ": " + strings.Join(details, ", ")
+		}
+		sb.WriteString(line + "\n")
+	}
+	return sb.String()
+}
+
+func markdownDiff(d *SchemaDiff) string {
+	var sb strings.Builder
+	if d.Empty() {
+		return "No schema changes.\n"
+	}
+	code := func(s string) string { return "`" + s + "`" }
+	section := func(heading string) {
+		if sb.Len() > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString("### " + heading + "\n\n")
+	}
+	if len(d.Tables) > 0 {
+		section("Tables")
+		for _, t := range d.Tables {
+			line := "- **" + t.Kind + "** " + code(t.Name)
+			if t.Kind == ChangeRenamed {
+				line += " (from " + code(t.OldName) + ")"
+			}
+			sb.WriteString(line + "\n")
+			for _, a := range t.Attributes {
+				sb.WriteString("  - attribute " + describeAttribute(a) + "\n")
+			}
+			for _, c := range t.Columns {
+				line := "  - column " + code(c.Name) + " " + c.Kind
+				if details := columnDetails(c); len(details) > 0 {
+					line += ": " + strings.Join(details, ", ")
+				}
+				sb.WriteString(line + "\n")
+			}
+		}
+	}
+	if len(d.Relations) > 0 {
+		section("Relations")
+		for _, r := range d.Relations {
+			line := "- **" + r.Kind + "** " + code(describeRelation(r))
+			for _, a := range r.Attributes {
+				line += ", " + describeAttribute(a)
+			}
+			sb.WriteString(line + "\n")
+		}
+	}
+	if len(d.Enums) > 0 {
+		section("Enums")
+		for _, e := range d.Enums {
+			line := "- **" + e.Kind + "** " + code(e.Name)
+			if details := enumDetails(e); len(details) > 0 {
+				line += ": " + strings.Join(details, ", ")
+			}
+			sb.WriteString(line + "\n")
+		}
+	}
+	if len(d.Groups) > 0 {
+		section("Groups")
+		for _, g := range d.Groups {
+			line := "- **" + g.Kind + "** " + code(g.Name)
+			if details := groupDetails(g); len(details) > 0 {
+				line += ": " + strings.Join(details, ", ")
+			}
+			sb.WriteString(line + "\n")
+		}
+	}
+	if len(d.Notes) > 0 {
+		section("Notes")
+		for _, n := range d.Notes {
+			line := "- **" + n.Kind + "** " + markdownCell(n.Text)
+			for _, a := range n.Attributes {
+				line += ", " + describeAttribute(a)
+			}
+			sb.WriteString(line + "\n")
+		}
+	}
+	return sb.String()
+}
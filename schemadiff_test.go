@@ -0,0 +1,225 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const diffOld = `
+[person] {bgcolor: "#fff"}
+*id
+name {label: "varchar"}
+fax
+
+[people_address]
+*id
+street
+
+[legacy]
+*id
+
+person 1--* people_address
+legacy *--1 person
+`
+
+const diffNew = `
+[person] {bgcolor: "#eee"}
+*+id
+name {label: "text"}
+email
+
+[address]
+*id
+street
+
+[team]
+*id
+
+address 1--1 person {label: "home"}
+person *--1 team
+`
+
+func TestDiffSchemas(t *testing.T) {
+	old, err := parseErd(diffOld)
+	if err != nil {
+		t.Fatal(err)
+	}
+	new, err := parseErd(diffNew)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `~ table person
+    ~ bgcolor "#fff" -> "#eee"
+    ~ column id: key primary -> primary, foreign
+    ~ column name: label "varchar" -> "text"
+    + column email
+    - column fax
+~ table address (renamed from people_address)
++ table team
+- table legacy
+~ relation address *--1 person -> address 1--1 person: label "home" added
++ relation person *--1 team
+- relation legacy *--1 person
+`
+	var out bytes.Buffer
+	err = writeDiff(&out, DiffSchemas(old, new), "text")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != want {
+		t.Errorf("got: %s\nwant: %s", out.String(), want)
+	}
+
+	if d := DiffSchemas(new, new); !d.Empty() {
+		t.Errorf("got: %v\nwant: no changes", d)
+	}
+}
+
+func TestDiffSchemas_enumsGroupsNotes(t *testing.T) {
+	old, err := parseErd(`enum pos {QB, RB}
+enum legacy {x}
+group Core {color: red} {player, team}
+group Old {draft}
+[player]
+*id
+[team]
+*id
+[draft]
+*id
+note "about players" {attach: player}
+note "free"
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	new, err := parseErd(`enum pos {QB, WR}
+enum phase {Regular}
+group Core {color: blue} {player, game}
+[player]
+*id
+[team]
+*id
+[game]
+*id
+note "about players" {attach: team}
+note "new"
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := DiffSchemas(old, new)
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"text", `+ table game
+- table draft
+~ enum pos: value "WR" added, value "RB" removed
++ enum phase
+- enum legacy
+~ group Core: table game added, table team removed, color "red" -> "blue"
+- group Old
+~ note "about players": attach "player" -> "team"
++ note "new"
+- note "free"
+`},
+		{"markdown", `### Tables
+
+- **added** ` + "`game`" + `
+- **removed** ` + "`draft`" + `
+
+### Enums
+
+- **changed** ` + "`pos`" + `: value "WR" added, value "RB" removed
+- **added** ` + "`phase`" + `
+- **removed** ` + "`legacy`" + `
+
+### Groups
+
+- **changed** ` + "`Core`" + `: table game added, table team removed, color "red" -> "blue"
+- **removed** ` + "`Old`" + `
+
+### Notes
+
+- **changed** about players, attach "player" -> "team"
+- **added** new
+- **removed** free
+`},
+	}
+	for _, tt := range tests {
+		var out bytes.Buffer
+		if err := writeDiff(&out, d, tt.format); err != nil {
+			t.Fatal(err)
+		}
+		if out.String() != tt.want {
+			t.Errorf("%s\ngot: %s\nwant: %s", tt.format, out.String(), tt.want)
+		}
+	}
+
+	var out bytes.Buffer
+	if err := writeDiff(&out, d, "json"); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"enums": [`, `"added": [
+        "WR"
+      ]`, `"groups": [`, `"notes": [`} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("%q not found in\n%s", want, out.String())
+		}
+	}
+}
+
+func TestDiffModel(t *testing.T) {
+	old, err := parseErd(diffOld)
+	if err != nil {
+		t.Fatal(err)
+	}
+	new, err := parseErd(diffNew)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := DiffModel(old, new, DiffSchemas(old, new))
+
+	tables := map[string]string{}
+	for _, name := range e.TableNames {
+		tables[name] = e.Tables[name].Change
+	}
+	want := map[string]string{"person": "changed", "address": "changed", "team": "added", "legacy": "removed"}
+	if !reflect.DeepEqual(tables, want) {
+		t.Errorf("got: %v\nwant: %v", tables, want)
+	}
+
+	var columns []string
+	for _, c := range e.Tables["person"].Columns {
+		columns = append(columns, c.Title+":"+c.Change)
+	}
+	if got := strings.Join(columns, " "); got != "*+id:changed name:changed email:added fax:removed" {
+		t.Errorf("got: %s\nwant: %s", got, "*+id:changed name:changed email:added fax:removed")
+	}
+
+	var relations []string
+	for _, r := range e.Relations {
+		relations = append(relations, r.LeftTableName+"-"+r.RightTableName+":"+r.Change)
+	}
+	if got := strings.Join(relations, " "); got != "address-person:changed person-team:added legacy-person:removed" {
+		t.Errorf("got: %s\nwant: %s", got, "address-person:changed person-team:added legacy-person:removed")
+	}
+	if new.Tables["person"].Columns[0].Change != "" {
+		t.Errorf("got: %q\nwant: the new schema left as it is", new.Tables["person"].Columns[0].Change)
+	}
+
+	var out bytes.Buffer
+	err = loadTemplates("").ExecuteTemplate(&out, "dot", e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{`<S>fax</S>`, `<S><B>legacy</B></S>`, `color="#c62828",fontcolor="#c62828",penwidth=2,style=dashed`} {
+		if !strings.Contains(out.String(), s) {
+			t.Errorf("got: %s\nwant: %s", out.String(), s)
+		}
+	}
+}
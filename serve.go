@@ -0,0 +1,258 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	texttemplate "text/template"
+	"unicode/utf8"
+)
+
+// maxRenderSize limits the sources posted to /render
+const maxRenderSize = 1 << 20
+
+// ServeCommand serves a live preview of .er files
+type ServeCommand struct {
+	Addr string `short:"a" long:"addr" default:"localhost:8080" description:"address to listen on."`
+}
+
+var serveCommand ServeCommand
+
+// Execute serves the diagram of the given files, rendering it again when they change
+func (c *ServeCommand) Execute(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("no input files")
+	}
+	t, err := templates(opts.Notation, opts.TemplateDir, opts.Templates)
+	if err != nil {
+		return err
+	}
+	p := newPreview(args, t)
+	w := &watcher{Patterns: args, Interval: watchInterval, Debounce: watchDebounce}
+	go w.Run(p.update, nil)
+
+	logStderr.Printf("serving %s on http://%s/\n", strings.Join(args, " "), c.Addr)
+	return http.ListenAndServe(c.Addr, p)
+}
+
+// previewPage is the data of the preview template
+type previewPage struct {
+	Title  string
+	Errors inputErrors // errors in the sources, shown with their line
+	Error  string
+	SVG    template.HTML
+	Dot    string // shown when the SVG could not be rendered
+}
+
+// preview serves the diagram of the input files and notifies the browsers
+// when it changes
+type preview struct {
+	http.ServeMux
+	patterns  []string
+	templates *template.Template
+	dot       *texttemplate.Template // of the diagram
+
+	mu      sync.Mutex
+	version int
+	page    previewPage
+	clients map[chan int]bool
+}
+
+func newPreview(patterns []string, dot *texttemplate.Template) *preview {
+	page, _ := Asset("templates/preview.html")
+	p := &preview{
+		patterns: patterns,
+		templates: template.Must(template.New("").Funcs(template.FuncMap{
+			"caret": caret,
+		}).Parse(string(page))),
+		dot:     dot,
+		page:    previewPage{Title: strings.Join(patterns, " ")},
+		clients: map[chan int]bool{},
+	}
+	p.HandleFunc("/", p.serveIndex)
+	p.HandleFunc("/diagram", p.serveDiagram)
+	p.HandleFunc("/events", p.serveEvents)
+	p.HandleFunc("/render", p.serveRender)
+	return p
+}
+
+// caret returns the whitespace of the line up to the column followed by a caret
+func caret(line string, column int) string {
+	var sb strings.Builder
+	for i, r := range line {
+		if utf8.RuneCountInString(line[:i]) >= column-1 {
+			break
+		}
+		if r != '\t' {
+			r = ' '
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String() + "^"
+}
+
+// diagram renders the inputs with the templates t into the data of the
+// preview page
+func diagram(title string, inputs []input, t *texttemplate.Template) previewPage {
+	page := previewPage{Title: title}
+	erd, err := load(inputs)
+	if err == nil {
+		erd, err = filtered(erd)
+	}
+	if err != nil {
+		if errs, ok := err.(inputErrors); ok {
+			page.Errors = errs
+		} else {
+			page.Error = err.Error()
+		}
+		return page
+	}
+
+	var svg bytes.Buffer
+	err = renderFormat(context.Background(), erd, t, "svg", &svg)
+	if err != nil {
+		page.Error = err.Error()
+		var dot bytes.Buffer
+		renderFormat(context.Background(), erd, t, "", &dot)
+		page.Dot = dot.String()
+		return page
+	}
+	// drop the XML prolog, the SVG is embedded in the page
+	s := svg.String()
+	if i := strings.Index(s, "<svg"); i >= 0 {
+		s = s[i:]
+	}
+	page.SVG = template.HTML(s)
+	return page
+}
+
+// update renders the inputs again and notifies the browsers
+func (p *preview) update() {
+	title := strings.Join(p.patterns, " ")
+	var page previewPage
+	inputs, err := readInputs(p.patterns)
+	if err != nil {
+		page = previewPage{Title: title, Error: err.Error()}
+	} else {
+		page = diagram(title, inputs, p.dot)
+	}
+	if page.Error != "" || len(page.Errors) > 0 {
+		logStderr.Println(strings.TrimSpace(page.Errors.Error() + "\n" + page.Error))
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.page = page
+	p.version++
+	for c := range p.clients {
+		select {
+		case c <- p.version:
+		default:
+			// the browser is behind, it fetches the latest diagram anyway
+		}
+	}
+}
+
+func (p *preview) execute(w http.ResponseWriter, name string) {
+	p.mu.Lock()
+	page := p.page
+	p.mu.Unlock()
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	err := p.templates.ExecuteTemplate(w, name, page)
+	if err != nil {
+		logStderr.Println(err)
+	}
+}
+
+func (p *preview) serveIndex(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	p.execute(w, "preview")
+}
+
+func (p *preview) serveDiagram(w http.ResponseWriter, r *http.Request) {
+	p.execute(w, "preview_diagram")
+}
+
+// serveEvents sends a server-sent event whenever the diagram changes
+func (p *preview) serveEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	c := make(chan int, 1)
+	p.mu.Lock()
+	p.clients[c] = true
+	p.mu.Unlock()
+	defer func() {
+		p.mu.Lock()
+		delete(p.clients, c)
+		p.mu.Unlock()
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case version := <-c:
+			fmt.Fprintf(w, "data: %d\n\n", version)
+			flusher.Flush()
+		}
+	}
+}
+
+// renderContentTypes are the formats of /render
+var renderContentTypes = map[string]string{
+	"svg":      "image/svg+xml",
+	"dot":      "text/vnd.graphviz; charset=utf-8",
+	"json":     "application/json",
+	"markdown": "text/markdown; charset=utf-8",
+	"html":     "text/html; charset=utf-8",
+	"png":      "image/png",
+	"pdf":      "application/pdf",
+}
+
+// serveRender renders the posted .er source in the format given by the
+// format parameter, svg by default
+func (p *preview) serveRender(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "svg"
+	}
+	contentType, ok := renderContentTypes[format]
+	if !ok {
+		http.Error(w, fmt.Sprintf("unknown format %q", format), http.StatusBadRequest)
+		return
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRenderSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	out, err := renderSource(r.Context(), p.dot, "<request>", string(body), format)
+	if err != nil {
+		http.Error(w, err.Error(), renderStatus(err))
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Write(out)
+}
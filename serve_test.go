@@ -0,0 +1,70 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestServeRender(t *testing.T) {
+	server := httptest.NewServer(newPreview([]string{"schema.er"}, loadTemplates("")))
+	defer server.Close()
+
+	tests := []struct {
+		format      string
+		body        string
+		status      int
+		contentType string
+		contains    string
+	}{
+		{"dot", "[a]\n*id\n", http.StatusOK, "text/vnd.graphviz; charset=utf-8", "graph {"},
+		{"json", "[a]\n*id\n", http.StatusOK, "application/json", `"primary_key": true`},
+		{"json", "[a]\n[a]\n", http.StatusBadRequest, "text/plain; charset=utf-8", `<request>:2:2: table "a" is already defined at line 1`},
+		{"gif", "[a]\n", http.StatusBadRequest, "text/plain; charset=utf-8", `unknown format "gif"`},
+	}
+	for _, tt := range tests {
+		resp, err := http.Post(server.URL+"/render?format="+tt.format, "text/plain", strings.NewReader(tt.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != tt.status || resp.Header.Get("Content-Type") != tt.contentType {
+			t.Errorf("%s: got: %d %s\nwant: %d %s", tt.format, resp.StatusCode, resp.Header.Get("Content-Type"), tt.status, tt.contentType)
+		}
+		if !strings.Contains(string(body), tt.contains) {
+			t.Errorf("%s: got: %s\nwant: %s", tt.format, body, tt.contains)
+		}
+	}
+}
+
+func TestDiagram_errors(t *testing.T) {
+	page := diagram("a.er", []input{{Name: "a.er", Contents: "[a]\n\t*id\n[a]\n"}}, loadTemplates(""))
+	if len(page.Errors) != 1 || page.Errors[0].Line != 3 || page.Errors[0].Text != "[a]" {
+		t.Fatalf("got: %v\nwant: the duplicate table on line 3", page.Errors)
+	}
+
+	if got := caret("\t*id {x: y}", 6); got != "\t    ^" {
+		t.Errorf("got: %q\nwant: %q", got, "\t    ^")
+	}
+}
+
+func TestErd_MarshalJSON(t *testing.T) {
+	erd, err := parseErd("[my-table] {label: \"x\"}\n*+id\nname\n[b]\n*id\nmy-table *--1 b\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := json.Marshal(erd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"tables":[{"name":"my-table","attributes":{"label":"x"},"columns":[{"name":"id","primary_key":true,"foreign_key":true},{"name":"name"}]},` +
+		`{"name":"b","columns":[{"name":"id","primary_key":true}]}],` +
+		`"relations":[{"left":"my-table","left_cardinality":"*","right":"b","right_cardinality":"1"}]}`
+	if string(body) != want {
+		t.Errorf("got: %s\nwant: %s", body, want)
+	}
+}
@@ -0,0 +1,331 @@
+package main
+
+import (
+	"bytes"
+	"compress/zlib"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+)
+
+// ServerCommand serves an HTTP API rendering .er sources
+type ServerCommand struct {
+	Addr    string        `short:"a" long:"addr" default:":8080" description:"address to listen on."`
+	MaxSize int64         `long:"max-size" default:"1048576" description:"largest source accepted, in bytes."`
+	Timeout time.Duration `long:"timeout" default:"10s" description:"time allowed to render a diagram."`
+	Cache   int           `long:"cache" default:"256" description:"number of rendered diagrams kept in memory, 0 disables the cache."`
+}
+
+var serverCommand ServerCommand
+
+// Execute runs the rendering API until the server fails
+func (c *ServerCommand) Execute(args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %s", strings.Join(args, " "))
+	}
+	t, err := templates(opts.Notation, opts.TemplateDir, opts.Templates)
+	if err != nil {
+		return err
+	}
+	server := &http.Server{
+		Addr:              c.Addr,
+		Handler:           newRenderServer(c.MaxSize, c.Timeout, c.Cache, t),
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       c.Timeout + 10*time.Second,
+		WriteTimeout:      c.Timeout + 10*time.Second,
+	}
+	logStderr.Printf("listening on %s\n", c.Addr)
+	return server.ListenAndServe()
+}
+
+// sourceError is a mistake in a rendered source, as opposed to a failure
+// of the renderer
+type sourceError struct {
+	error
+}
+
+// renderSource renders a single .er source in one of the formats of
+// renderContentTypes, with the templates t
+func renderSource(ctx context.Context, t *template.Template, name, source, format string) ([]byte, error) {
+	erd, err := load([]input{{Name: name, Contents: source}})
+	if err == nil {
+		erd, err = filtered(erd)
+	}
+	if err != nil {
+		return nil, sourceError{err}
+	}
+	if format == "dot" {
+		format = ""
+	}
+	var out bytes.Buffer
+	err = renderFormat(ctx, erd, t, format, &out)
+	if err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// renderStatus returns the status code of a renderSource error
+func renderStatus(err error) int {
+	switch {
+	case err == context.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case err == context.Canceled:
+		return http.StatusServiceUnavailable
+	}
+	if _, ok := err.(sourceError); ok {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+// renderCache keeps the most recently rendered diagrams
+type renderCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List // of *cacheEntry, most recent first
+	entries map[string]*list.Element
+}
+
+type cacheEntry struct {
+	key  string
+	body []byte
+}
+
+func newRenderCache(size int) *renderCache {
+	return &renderCache{size: size, order: list.New(), entries: map[string]*list.Element{}}
+}
+
+// cacheKey identifies a source rendered in a format
+func cacheKey(format, source string) string {
+	sum := sha256.Sum256([]byte(source))
+	return format + ":" + base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func (c *renderCache) get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(e)
+	return e.Value.(*cacheEntry).body, true
+}
+
+func (c *renderCache) add(key string, body []byte) {
+	if c.size <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[key]; ok {
+		c.order.MoveToFront(e)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&cacheEntry{key: key, body: body})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+// serverMetrics counts the requests of the rendering API
+type serverMetrics struct {
+	mu            sync.Mutex
+	requests      map[[2]string]int // by format and status code
+	cacheHits     int
+	cacheMisses   int
+	renders       int
+	renderSeconds float64
+}
+
+func (m *serverMetrics) request(format string, status int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests[[2]string{format, fmt.Sprint(status)}]++
+}
+
+func (m *serverMetrics) cache(hit bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if hit {
+		m.cacheHits++
+	} else {
+		m.cacheMisses++
+	}
+}
+
+func (m *serverMetrics) render(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.renders++
+	m.renderSeconds += d.Seconds()
+}
+
+// write prints the metrics in the Prometheus text format
+func (m *serverMetrics) write(w io.Writer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var keys [][2]string
+	for k := range m.requests {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i][0] != keys[j][0] {
+			return keys[i][0] < keys[j][0]
+		}
+		return keys[i][1] < keys[j][1]
+	})
+	fmt.Fprintln(w, "# HELP erd_requests_total Render requests by format and status code.")
+	fmt.Fprintln(w, "# TYPE erd_requests_total counter")
+	for _, k := range keys {
+		fmt.Fprintf(w, "erd_requests_total{format=%q,code=%q} %d\n", k[0], k[1], m.requests[k])
+	}
+	fmt.Fprintln(w, "# HELP erd_cache_hits_total Renders answered from the cache.")
+	fmt.Fprintln(w, "# TYPE erd_cache_hits_total counter")
+	fmt.Fprintf(w, "erd_cache_hits_total %d\n", m.cacheHits)
+	fmt.Fprintln(w, "# HELP erd_cache_misses_total Renders not found in the cache.")
+	fmt.Fprintln(w, "# TYPE erd_cache_misses_total counter")
+	fmt.Fprintf(w, "erd_cache_misses_total %d\n", m.cacheMisses)
+	fmt.Fprintln(w, "# HELP erd_render_seconds Time spent rendering diagrams.")
+	fmt.Fprintln(w, "# TYPE erd_render_seconds summary")
+	fmt.Fprintf(w, "erd_render_seconds_sum %g\n", m.renderSeconds)
+	fmt.Fprintf(w, "erd_render_seconds_count %d\n", m.renders)
+}
+
+// renderServer is the rendering API:
+//
+//	POST /render/{format}           the source is the request body
+//	GET  /render/{format}/{source}  the source is deflated and base64url encoded
+//	GET  /healthz
+//	GET  /metrics
+type renderServer struct {
+	maxSize   int64
+	timeout   time.Duration
+	templates *template.Template
+	cache     *renderCache
+	metrics   *serverMetrics
+}
+
+func newRenderServer(maxSize int64, timeout time.Duration, cacheSize int, t *template.Template) *renderServer {
+	return &renderServer{
+		maxSize:   maxSize,
+		timeout:   timeout,
+		templates: t,
+		cache:     newRenderCache(cacheSize),
+		metrics:   &serverMetrics{requests: map[[2]string]int{}},
+	}
+}
+
+func (s *renderServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/healthz":
+		fmt.Fprintln(w, "ok")
+	case r.URL.Path == "/metrics":
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		s.metrics.write(w)
+	case strings.HasPrefix(r.URL.Path, "/render/"):
+		s.serveRender(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// decodeSource decodes a source of a GET request, deflated with a zlib
+// header and base64url encoded like Kroki does
+func decodeSource(encoded string, maxSize int64) (string, error) {
+	compressed, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(encoded, "="))
+	if err != nil {
+		return "", fmt.Errorf("invalid base64: %v", err)
+	}
+	zr, err := zlib.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return "", fmt.Errorf("invalid deflate: %v", err)
+	}
+	defer zr.Close()
+	source, err := ioutil.ReadAll(io.LimitReader(zr, maxSize+1))
+	if err != nil {
+		return "", fmt.Errorf("invalid deflate: %v", err)
+	}
+	if int64(len(source)) > maxSize {
+		return "", errTooLarge
+	}
+	return string(source), nil
+}
+
+var errTooLarge = fmt.Errorf("source larger than the limit")
+
+func (s *renderServer) serveRender(w http.ResponseWriter, r *http.Request) {
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/render/"), "/", 2)
+	format := parts[0]
+	status := http.StatusOK
+	defer func() { s.metrics.request(format, status) }()
+	fail := func(code int, message string) {
+		status = code
+		http.Error(w, message, code)
+	}
+
+	contentType, ok := renderContentTypes[format]
+	if !ok {
+		format = "unknown"
+		fail(http.StatusNotFound, fmt.Sprintf("unknown format %q", parts[0]))
+		return
+	}
+
+	var source string
+	switch {
+	case r.Method == http.MethodPost && len(parts) == 1:
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, s.maxSize))
+		if err != nil {
+			fail(http.StatusRequestEntityTooLarge, errTooLarge.Error())
+			return
+		}
+		source = string(body)
+	case r.Method == http.MethodGet && len(parts) == 2:
+		var err error
+		source, err = decodeSource(parts[1], s.maxSize)
+		if err == errTooLarge {
+			fail(http.StatusRequestEntityTooLarge, err.Error())
+			return
+		}
+		if err != nil {
+			fail(http.StatusBadRequest, err.Error())
+			return
+		}
+	default:
+		fail(http.StatusMethodNotAllowed, "use POST /render/{format} or GET /render/{format}/{source}")
+		return
+	}
+
+	key := cacheKey(format, source)
+	body, hit := s.cache.get(key)
+	s.metrics.cache(hit)
+	if !hit {
+		ctx, cancel := context.WithTimeout(r.Context(), s.timeout)
+		defer cancel()
+		start := time.Now()
+		var err error
+		body, err = renderSource(ctx, s.templates, "<request>", source, format)
+		s.metrics.render(time.Since(start))
+		if err != nil {
+			fail(renderStatus(err), err.Error())
+			return
+		}
+		s.cache.add(key, body)
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
+}
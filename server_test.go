@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func encodeSource(source string) string {
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+	zw.Write([]byte(source))
+	zw.Close()
+	return base64.URLEncoding.EncodeToString(buf.Bytes())
+}
+
+func TestRenderServer(t *testing.T) {
+	server := httptest.NewServer(newRenderServer(64, time.Second, 8, loadTemplates("")))
+	defer server.Close()
+
+	get := func(path string) (*http.Response, error) { return http.Get(server.URL + path) }
+	post := func(path, body string) func() (*http.Response, error) {
+		return func() (*http.Response, error) {
+			return http.Post(server.URL+path, "text/plain", strings.NewReader(body))
+		}
+	}
+	tests := []struct {
+		name     string
+		do       func() (*http.Response, error)
+		status   int
+		contains string
+	}{
+		{"post", post("/render/dot", "[a]\n*id\n"), http.StatusOK, "graph {"},
+		{"get", func() (*http.Response, error) { return get("/render/json/" + encodeSource("[a]\n*id\n")) }, http.StatusOK, `"name": "a"`},
+		{"cached", post("/render/dot", "[a]\n*id\n"), http.StatusOK, "graph {"},
+		{"invalid source", post("/render/dot", "[a]\n[a]\n"), http.StatusBadRequest, `<request>:2:2: table "a" is already defined`},
+		{"invalid encoding", func() (*http.Response, error) { return get("/render/dot/!!") }, http.StatusBadRequest, "invalid base64"},
+		{"too large", post("/render/dot", strings.Repeat("# comment\n", 10)), http.StatusRequestEntityTooLarge, "larger than the limit"},
+		{"too large deflated", func() (*http.Response, error) {
+			return get("/render/dot/" + encodeSource(strings.Repeat("# comment\n", 10)))
+		}, http.StatusRequestEntityTooLarge, "larger than the limit"},
+		{"unknown format", post("/render/gif", "[a]\n"), http.StatusNotFound, `unknown format "gif"`},
+		{"wrong method", func() (*http.Response, error) { return get("/render/dot") }, http.StatusMethodNotAllowed, "use POST"},
+		{"health", func() (*http.Response, error) { return get("/healthz") }, http.StatusOK, "ok"},
+		{"metrics", func() (*http.Response, error) { return get("/metrics") }, http.StatusOK,
+			"erd_requests_total{format=\"dot\",code=\"200\"} 2\n" +
+				"erd_requests_total{format=\"dot\",code=\"400\"} 2\n" +
+				"erd_requests_total{format=\"dot\",code=\"405\"} 1\n" +
+				"erd_requests_total{format=\"dot\",code=\"413\"} 2\n" +
+				"erd_requests_total{format=\"json\",code=\"200\"} 1\n" +
+				"erd_requests_total{format=\"unknown\",code=\"404\"} 1\n" +
+				"# HELP erd_cache_hits_total Renders answered from the cache.\n" +
+				"# TYPE erd_cache_hits_total counter\n" +
+				"erd_cache_hits_total 1\n"},
+	}
+	for _, tt := range tests {
+		resp, err := tt.do()
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != tt.status {
+			t.Errorf("%s: got: %d\nwant: %d", tt.name, resp.StatusCode, tt.status)
+		}
+		if !strings.Contains(string(body), tt.contains) {
+			t.Errorf("%s: got: %s\nwant: %s", tt.name, body, tt.contains)
+		}
+	}
+}
+
+func TestRenderCache(t *testing.T) {
+	c := newRenderCache(2)
+	c.add("a", []byte("a"))
+	c.add("b", []byte("b"))
+	c.get("a")
+	c.add("c", []byte("c"))
+
+	for key, want := range map[string]bool{"a": true, "b": false, "c": true} {
+		if _, ok := c.get(key); ok != want {
+			t.Errorf("%s: got: %v\nwant: %v", key, ok, want)
+		}
+	}
+}
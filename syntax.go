@@ -0,0 +1,416 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// SyntaxKind is the kind of a node in the syntax tree
+type SyntaxKind int
+
+// Kinds of syntax nodes
+const (
+	SyntaxComment      SyntaxKind = iota // a '#' comment line
+	SyntaxBlank                          // one or more blank lines
+	SyntaxTitle                          // title { ... }
+	SyntaxGraph                          // graph { ... }
+	SyntaxColors                         // colors { ... }
+	SyntaxGroup                          // group name { ... } { ... }
+	SyntaxEnum                           // enum name { ... }
+	SyntaxNote                           // note "text" { ... }
+	SyntaxTable                          // [name] extends parent { ... } and its columns
+	SyntaxColumn                         // a column of a table
+	SyntaxRelation                       // left 1--* right { ... }
+	SyntaxRelationship                   // <name> a 1, b *, c * { ... } and its columns
+	SyntaxAttribute                      // key: value
+	SyntaxName                           // name of a table, column, group, enum or relationship
+	SyntaxReference                      // table named by a relation, a relationship, a group member or extends
+	SyntaxCardinality                    // one side of a relation operator
+	SyntaxOperator                       // -- or == between the cardinalities
+	SyntaxKey                            // key of an attribute
+	SyntaxValue                          // value of an attribute or an enum, text of a note
+	SyntaxType                           // SQL type of a column
+	SyntaxConstraint                     // not null, null, unique, default x or check (x) of a column
+)
+
+// Position is a location in a source
+type Position struct {
+	Offset int // byte offset
+	Line   int // 1-based line
+	Column int // 1-based column, in runes
+}
+
+func (p Position) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// SyntaxNode is a node of the syntax tree, covering the source from Begin
+// up to End
+type SyntaxNode struct {
+	Kind     SyntaxKind
+	Begin    Position
+	End      Position
+	Text     string // unquoted names, keys and values, comments without the '#'
+	Children []*SyntaxNode
+}
+
+// SyntaxFile is the syntax tree of a .er source. Everything in the source
+// which is not covered by a node is whitespace or punctuation, so the tree
+// together with the source is lossless.
+type SyntaxFile struct {
+	Source     string
+	Statements []*SyntaxNode
+}
+
+// SyntaxError is a syntax error in a .er source
+type SyntaxError struct {
+	Position
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%v: %s", e.Position, e.Message)
+}
+
+// Child returns the first child of the given kind
+func (n *SyntaxNode) Child(kind SyntaxKind) *SyntaxNode {
+	for _, c := range n.Children {
+		if c.Kind == kind {
+			return c
+		}
+	}
+	return nil
+}
+
+// ChildrenOf returns the children of the given kind
+func (n *SyntaxNode) ChildrenOf(kind SyntaxKind) []*SyntaxNode {
+	var children []*SyntaxNode
+	for _, c := range n.Children {
+		if c.Kind == kind {
+			children = append(children, c)
+		}
+	}
+	return children
+}
+
+// Contains reports whether the byte offset lies within the node
+func (n *SyntaxNode) Contains(offset int) bool {
+	return n.Begin.Offset <= offset && offset <= n.End.Offset
+}
+
+// Raw returns the source text of a node
+func (f *SyntaxFile) Raw(n *SyntaxNode) string {
+	return f.Source[n.Begin.Offset:n.End.Offset]
+}
+
+// Position returns the position of a byte offset in the source
+func (f *SyntaxFile) Position(offset int) Position {
+	before := f.Source[:offset]
+	line := strings.Count(before, "\n") + 1
+	column := utf8.RuneCountInString(before[strings.LastIndex(before, "\n")+1:]) + 1
+	return Position{Offset: offset, Line: line, Column: column}
+}
+
+// NodeAt returns the nodes containing the byte offset, from the statement
+// down to the innermost node
+func (f *SyntaxFile) NodeAt(offset int) []*SyntaxNode {
+	var path []*SyntaxNode
+	nodes := f.Statements
+	for {
+		var found *SyntaxNode
+		for _, n := range nodes {
+			if n.Kind != SyntaxBlank && n.Contains(offset) {
+				found = n
+				break
+			}
+		}
+		if found == nil {
+			return path
+		}
+		path = append(path, found)
+		nodes = found.Children
+	}
+}
+
+// Walk calls fn for every node of the tree, parents before their children
+func (f *SyntaxFile) Walk(fn func(n *SyntaxNode)) {
+	var walk func(nodes []*SyntaxNode)
+	walk = func(nodes []*SyntaxNode) {
+		for _, n := range nodes {
+			fn(n)
+			walk(n.Children)
+		}
+	}
+	walk(f.Statements)
+}
+
+// SyntaxEdit replaces the source from Begin up to End (byte offsets) with Text
+type SyntaxEdit struct {
+	Begin int
+	End   int
+	Text  string
+}
+
+// Edit returns the source with the edits applied. Everything outside the
+// edited ranges, comments and whitespace included, is kept as it is.
+func (f *SyntaxFile) Edit(edits []SyntaxEdit) (string, error) {
+	sorted := append([]SyntaxEdit(nil), edits...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Begin < sorted[j].Begin
+	})
+
+	var sb strings.Builder
+	last := 0
+	for _, e := range sorted {
+		if e.Begin < last || e.End < e.Begin || e.End > len(f.Source) {
+			return "", fmt.Errorf("invalid edit %d-%d", e.Begin, e.End)
+		}
+		sb.WriteString(f.Source[last:e.Begin])
+		sb.WriteString(e.Text)
+		last = e.End
+	}
+	sb.WriteString(f.Source[last:])
+	return sb.String(), nil
+}
+
+// syntaxBuilder converts the parse tree of the generated parser
+type syntaxBuilder struct {
+	source    string
+	offsets   []int // byte offset of every rune, the parser counts in runes
+	positions []Position
+}
+
+func newSyntaxBuilder(source string) *syntaxBuilder {
+	b := &syntaxBuilder{source: source}
+	line, column := 1, 1
+	for i, r := range source {
+		b.offsets = append(b.offsets, i)
+		b.positions = append(b.positions, Position{Offset: i, Line: line, Column: column})
+		column++
+		if r == '\n' {
+			line, column = line+1, 1
+		}
+	}
+	b.offsets = append(b.offsets, len(source))
+	b.positions = append(b.positions, Position{Offset: len(source), Line: line, Column: column})
+	return b
+}
+
+// position returns the position of a rune offset
+func (b *syntaxBuilder) position(offset uint32) Position {
+	return b.positions[offset]
+}
+
+func (b *syntaxBuilder) node(kind SyntaxKind, n *node32) *SyntaxNode {
+	return &SyntaxNode{Kind: kind, Begin: b.position(n.begin), End: b.position(n.end)}
+}
+
+// trimmed creates a node without the surrounding whitespace of n
+func (b *syntaxBuilder) trimmed(kind SyntaxKind, n *node32) *SyntaxNode {
+	begin, end := n.begin, n.end
+	for begin < end && strings.ContainsRune(" \t\r\n", b.rune(begin)) {
+		begin++
+	}
+	for end > begin && strings.ContainsRune(" \t\r\n", b.rune(end-1)) {
+		end--
+	}
+	return &SyntaxNode{Kind: kind, Begin: b.position(begin), End: b.position(end)}
+}
+
+func (b *syntaxBuilder) rune(offset uint32) rune {
+	r, _ := utf8.DecodeRuneInString(b.source[b.offsets[offset]:])
+	return r
+}
+
+func (b *syntaxBuilder) text(kind SyntaxKind, n *node32) *SyntaxNode {
+	node := b.node(kind, n)
+	node.Text = b.source[node.Begin.Offset:node.End.Offset]
+	return node
+}
+
+func (b *syntaxBuilder) quoted(kind SyntaxKind, n *node32) (*SyntaxNode, error) {
+	node := b.text(kind, n)
+	if strings.HasPrefix(node.Text, `"`) {
+		text, err := strconv.Unquote(node.Text)
+		if err != nil {
+			return nil, &SyntaxError{Position: node.Begin, Message: err.Error()}
+		}
+		node.Text = text
+	}
+	return node, nil
+}
+
+func (b *syntaxBuilder) attribute(n *node32) (*SyntaxNode, error) {
+	attr := b.node(SyntaxAttribute, n)
+	for c := n.up; c != nil; c = c.next {
+		switch c.pegRule {
+		case ruleattribute_key:
+			key, err := b.quoted(SyntaxKey, c)
+			if err != nil {
+				return nil, err
+			}
+			attr.Children = append(attr.Children, key)
+		case ruleattribute_value:
+			value, err := b.quoted(SyntaxValue, c)
+			if err != nil {
+				return nil, err
+			}
+			attr.Children = append(attr.Children, value)
+		}
+	}
+	return attr, nil
+}
+
+// constraint creates a node for a column constraint, its text in the
+// canonical spelling
+func (b *syntaxBuilder) constraint(n *node32) *SyntaxNode {
+	node := b.node(SyntaxConstraint, n)
+	for c := n.up; c != nil; c = c.next {
+		switch c.pegRule {
+		case rulecolumn_not_null:
+			node.Text = "not null"
+		case rulecolumn_null:
+			node.Text = "null"
+		case rulecolumn_unique:
+			node.Text = "unique"
+		}
+		for v := c.up; v != nil; v = v.next {
+			switch v.pegRule {
+			case ruledefault_value:
+				node.Text = "default " + b.text(SyntaxValue, v).Text
+			case rulecheck_condition:
+				node.Text = "check " + b.text(SyntaxValue, v).Text
+			}
+		}
+	}
+	return node
+}
+
+func (b *syntaxBuilder) blank(n *node32) *SyntaxNode {
+	node := b.node(SyntaxBlank, n)
+	if !strings.Contains(b.source[node.Begin.Offset:node.End.Offset], "\n") {
+		return nil
+	}
+	return node
+}
+
+func (b *syntaxBuilder) children(parent *SyntaxNode, n *node32) error {
+	for c := n.up; c != nil; c = c.next {
+		var child *SyntaxNode
+		var err error
+		switch c.pegRule {
+		case ruletitle_attribute, rulegraph_attribute, rulecolor_key_value, rulegroup_attribute,
+			ruletable_attribute, rulecolumn_attribute, rulerelation_attribute, rulerelationship_attribute,
+			rulenote_attribute:
+			child, err = b.attribute(c)
+		case ruletable_title, rulecolumn_name, rulerelationship_title, ruleenum_title:
+			child = b.text(SyntaxName, c)
+		case rulegroup_title:
+			child, err = b.quoted(SyntaxName, c)
+		case ruleenum_value, rulenote_text:
+			child, err = b.quoted(SyntaxValue, c)
+		case rulegroup_member, rulerelation_left, rulerelation_right, ruleparticipant_table, ruletable_parent:
+			child = b.text(SyntaxReference, c)
+		case rulecardinality_left, rulecardinality_right, ruleparticipant_cardinality:
+			child = b.text(SyntaxCardinality, c)
+		case rulerelation_operator:
+			child = b.text(SyntaxOperator, c)
+		case ruletable_column:
+			child = b.trimmed(SyntaxColumn, c)
+			err = b.children(child, c)
+		case rulerelationship_participant, rulecolumn_definition:
+			err = b.children(parent, c)
+		case rulecolumn_type:
+			child = b.text(SyntaxType, c)
+			child.Text = strings.Join(strings.Fields(child.Text), " ")
+		case rulecolumn_constraint:
+			child = b.constraint(c)
+		case ruleempty_line:
+			child = b.blank(c)
+		}
+		if err != nil {
+			return err
+		}
+		if child != nil {
+			parent.Children = append(parent.Children, child)
+		}
+	}
+	return nil
+}
+
+func (b *syntaxBuilder) statement(n *node32) (*SyntaxNode, error) {
+	var node *SyntaxNode
+	switch n.pegRule {
+	case rulecomment_line:
+		node = b.trimmed(SyntaxComment, n)
+		node.Text = strings.TrimPrefix(b.source[node.Begin.Offset:node.End.Offset], "#")
+		return node, nil
+	case ruleempty_line:
+		return b.blank(n), nil
+	case ruletitle_info:
+		node = b.trimmed(SyntaxTitle, n)
+	case rulegraph_info:
+		node = b.trimmed(SyntaxGraph, n)
+	case rulecolor_info:
+		node = b.trimmed(SyntaxColors, n)
+	case rulegroup_info:
+		node = b.trimmed(SyntaxGroup, n)
+	case ruleenum_info:
+		node = b.trimmed(SyntaxEnum, n)
+	case rulenote_info:
+		node = b.trimmed(SyntaxNote, n)
+	case ruletable_info:
+		node = b.trimmed(SyntaxTable, n)
+	case rulerelation_info:
+		node = b.trimmed(SyntaxRelation, n)
+	case rulerelationship_info:
+		node = b.trimmed(SyntaxRelationship, n)
+	default:
+		return nil, nil
+	}
+	return node, b.children(node, n)
+}
+
+// ParseSyntax parses a .er source into its syntax tree
+func ParseSyntax(source string) (*SyntaxFile, error) {
+	parser := &Parser{Buffer: source}
+	err := parser.Init()
+	if err != nil {
+		return nil, err
+	}
+	err = parser.Parse()
+	if err != nil {
+		return nil, err
+	}
+
+	b := newSyntaxBuilder(source)
+	f := &SyntaxFile{Source: source}
+	var end uint32
+	root := parser.AST()
+	if root != nil {
+		for n := root.up; n != nil; n = n.next {
+			if n.pegRule != ruleexpression {
+				continue
+			}
+			end = n.end
+			for s := n.up; s != nil; s = s.next {
+				statement, err := b.statement(s)
+				if err != nil {
+					return nil, err
+				}
+				if statement != nil {
+					f.Statements = append(f.Statements, statement)
+				}
+			}
+		}
+	}
+
+	// the grammar accepts anything after the last statement to report it
+	if b.offsets[end] < len(source) {
+		return nil, &SyntaxError{Position: b.position(end), Message: "syntax error"}
+	}
+	return f, nil
+}
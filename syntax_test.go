@@ -0,0 +1,121 @@
+package main
+
+import "testing"
+
+const syntaxSource = `# people
+[Person] {bgcolor: "#fcecec"}
+  *name {label: "名前"}
+  +birth_location_id
+
+Person *--1 Location {label: born}
+`
+
+func TestParseSyntax(t *testing.T) {
+	f, err := ParseSyntax(syntaxSource)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var kinds []SyntaxKind
+	for _, n := range f.Statements {
+		kinds = append(kinds, n.Kind)
+	}
+	want := []SyntaxKind{SyntaxComment, SyntaxTable, SyntaxRelation}
+	if len(kinds) != len(want) {
+		t.Fatalf("got: %v\nwant: %v", kinds, want)
+	}
+	for i := range want {
+		if kinds[i] != want[i] {
+			t.Fatalf("got: %v\nwant: %v", kinds, want)
+		}
+	}
+
+	table := f.Statements[1]
+	if got := f.Raw(table.Child(SyntaxName)); got != "Person" {
+		t.Errorf("got: %v\nwant: %v", got, "Person")
+	}
+	columns := table.ChildrenOf(SyntaxColumn)
+	if len(columns) != 2 {
+		t.Fatalf("got: %v columns\nwant: 2", len(columns))
+	}
+	if got := columns[1].Begin.String(); got != "4:3" {
+		t.Errorf("got: %v\nwant: %v", got, "4:3")
+	}
+	value := columns[0].Child(SyntaxAttribute).Child(SyntaxValue)
+	if value.Text != "名前" || f.Raw(value) != `"名前"` {
+		t.Errorf("got: %v %v", value.Text, f.Raw(value))
+	}
+
+	relation := f.Statements[2]
+	if got := relation.End; got.Line != 6 || got.Offset != len(syntaxSource)-1 {
+		t.Errorf("got: %+v\nwant: end of line 6", got)
+	}
+	refs := relation.ChildrenOf(SyntaxReference)
+	if len(refs) != 2 || refs[1].Text != "Location" {
+		t.Errorf("got: %v", refs)
+	}
+
+	// every node matches the position computed from its offset
+	f.Walk(func(n *SyntaxNode) {
+		if p := f.Position(n.Begin.Offset); p != n.Begin {
+			t.Errorf("got: %+v\nwant: %+v", n.Begin, p)
+		}
+	})
+}
+
+func TestSyntaxFile_NodeAt(t *testing.T) {
+	f, err := ParseSyntax(syntaxSource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	offset := len("# people\n[Person] {bgcolor: \"#fcecec\"}\n  *na")
+	path := f.NodeAt(offset)
+	if len(path) != 3 || path[2].Kind != SyntaxName || path[2].Text != "*name" {
+		t.Fatalf("got: %v", path)
+	}
+	if path[0].Kind != SyntaxTable || path[1].Kind != SyntaxColumn {
+		t.Errorf("got: %v %v", path[0].Kind, path[1].Kind)
+	}
+}
+
+func TestSyntaxFile_Edit(t *testing.T) {
+	f, err := ParseSyntax(syntaxSource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var edits []SyntaxEdit
+	f.Walk(func(n *SyntaxNode) {
+		if (n.Kind == SyntaxName || n.Kind == SyntaxReference) && n.Text == "Person" {
+			edits = append(edits, SyntaxEdit{Begin: n.Begin.Offset, End: n.End.Offset, Text: "Human"})
+		}
+	})
+	got, err := f.Edit(edits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `# people
+[Human] {bgcolor: "#fcecec"}
+  *name {label: "名前"}
+  +birth_location_id
+
+Human *--1 Location {label: born}
+`
+	if got != want {
+		t.Errorf("got:\n%v\nwant:\n%v", got, want)
+	}
+
+	if _, err := f.Edit([]SyntaxEdit{{Begin: 2, End: 5}, {Begin: 3, End: 4}}); err == nil {
+		t.Errorf("overlapping edits should be reported")
+	}
+}
+
+func TestParseSyntax_Error(t *testing.T) {
+	_, err := ParseSyntax("[a]\nid\n\n?? {x\n")
+	serr, ok := err.(*SyntaxError)
+	if !ok {
+		t.Fatalf("got: %v\nwant: *SyntaxError", err)
+	}
+	if serr.Line != 4 || serr.Column != 1 {
+		t.Errorf("got: %v:%v\nwant: 4:1", serr.Line, serr.Column)
+	}
+}
@@ -1,8 +1,19 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
+// templates/doc.html
 // templates/dot.tmpl
+// templates/dot_enums.tmpl
+// templates/dot_groups.tmpl
+// templates/dot_notes.tmpl
 // templates/dot_relations.tmpl
+// templates/dot_relations_chen.tmpl
+// templates/dot_relations_idef1x.tmpl
+// templates/dot_relations_minmax.tmpl
+// templates/dot_relations_uml.tmpl
+// templates/dot_relationships.tmpl
+// templates/dot_subtypes.tmpl
 // templates/dot_tables.tmpl
+// templates/preview.html
 
 package main
 
@@ -71,7 +82,27 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templatesDotTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x92\x5d\xcb\xd3\x30\x14\x80\xef\xfb\x2b\x42\xae\xbb\x98\x55\x87\x8a\xcb\xc0\x0b\x85\x81\x6e\xa2\xbb\xf2\x03\x49\x9b\xb3\x36\x9a\x25\x25\x39\x63\x60\xe8\x7f\x97\xb6\x1b\x4d\xe7\xf0\xed\x45\x39\x3c\xe7\x23\x4f\x4f\x13\xe3\x82\x28\x38\x6a\x0b\x84\x2a\x87\x94\x2c\xba\x2e\xab\xbd\x6c\x1b\x12\x33\x42\x08\x19\xe3\x6f\x43\xdc\x3f\x7d\x83\x3e\x12\x76\xd0\x68\x60\x7c\xbf\x45\xf4\xba\x3c\x23\x04\x66\x64\x09\x66\x98\x71\xab\x1f\x88\x58\xaf\xdf\xef\x77\x07\xf2\x69\xbf\xdd\x1d\x16\x5f\xb6\x5f\xdf\x09\x5a\x70\xba\x89\xf1\x7f\x73\xba\x6e\xfd\xac\x6f\xdb\x6c\xf2\xf9\xb8\x5f\xe7\x80\xc2\xdc\x41\xe3\x2a\x81\xf9\xcc\x13\xac\x9a\xb9\x58\xa7\x20\x40\x2b\x38\x5b\x4d\x85\x5e\xda\xdf\xff\xc0\x56\x2a\x41\x39\x2b\x72\xce\x0a\x3a\xe1\x93\xf4\xb5\xb6\x7d\x86\x27\xb4\x02\x5b\x81\x45\x2f\x11\x04\xfa\x33\x4c\x99\xd0\x1a\x6d\x21\x08\x3a\x06\x74\x7e\xaa\xd2\x5e\x7c\xf8\x3c\xa0\x1f\x6f\xb2\x9b\x60\xb2\xeb\x71\x77\xf4\xfb\x2e\x69\x3c\x3a\x8b\x41\xff\x01\xb1\x7c\xf1\xd0\xeb\x65\xce\x19\x5f\x25\x0d\x2d\xd8\x8b\x56\xd8\x88\x25\xe3\x89\x59\x23\x5b\x10\x1f\x3d\x54\xce\xab\x54\x01\x54\x9d\x2a\xf4\x8e\xa5\xc3\xe6\x91\x40\x31\x41\xe9\xbd\xbb\x0c\x94\xb3\xd7\x4f\x9d\x3d\x7c\x96\xb4\xb5\x01\xf1\xbc\xb8\xc3\x4a\x07\x94\xb6\x02\xb1\x64\xaf\x52\xad\x18\x11\x4e\xad\x91\x38\xde\xd3\x9f\x1e\x8c\x44\xed\x6c\xa0\x84\x5d\xff\xf0\x7d\x09\xca\xd2\xc0\x35\xdf\x65\x31\x82\x55\x5d\xf7\x37\x00\x00\xff\xff\xec\x09\x89\xb9\xf0\x02\x00\x00")
+var _templatesDocHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x55\x51\x8b\xe3\x36\x10\x7e\xf7\xaf\x98\x7a\xf7\x31\x8e\x37\x61\x59\x82\xad\x08\xca\x75\xa1\x70\xe5\x5a\xca\x52\xe8\x53\x51\xac\x71\xac\x56\x96\x8c\xac\xec\x39\x08\xff\xf7\x22\x59\xf6\xc6\xd7\xdd\x3e\x1d\x79\xc8\x48\x33\xfa\xe6\xd3\xf7\x8d\x6d\xe7\x38\xd6\x42\x21\xa4\x5c\x57\xe9\x38\x92\x1f\x7e\xfa\xf5\xd3\xcb\x9f\xbf\x3d\x43\x63\x5b\x49\x13\x32\xff\x21\xe3\x34\x21\x2d\x5a\x06\x55\xc3\x4c\x8f\xf6\x98\x5e\x6c\x9d\x1d\x52\x9a\x10\x2b\xac\x44\xea\x9c\x36\xb0\x7d\xf1\x31\xa4\x68\x78\x76\xd6\x1e\x31\x9f\xb2\x09\xe9\xed\xd5\xff\x03\x9c\x34\xbf\x82\x83\x96\x99\xb3\x50\x05\xec\x1f\xbb\xa1\x84\x5a\x2b\x9b\xd5\xac\x15\xf2\x5a\xc0\xcf\x28\x5f\xd1\x8a\x8a\x6d\xe0\x47\x23\x98\xdc\x40\xcf\x54\x9f\xf5\x68\x44\x5d\x42\xa5\xa5\x36\x05\xdc\xed\xf7\xfb\x12\xc6\x04\xc0\xb2\x93\x44\x70\x70\xd2\x86\xa3\xc9\x2a\x2d\x25\xeb\x7a\x2c\x60\x8e\xca\xa5\xd9\xa1\x1b\xe0\x01\x76\x4f\xdd\x10\x8f\x36\x1b\xb0\x7c\x39\x5b\xc0\xae\x1b\xa0\xd7\x52\x70\xb8\xe3\x9c\x97\xd0\x31\xce\x85\x3a\x17\xf0\xd8\x0d\xfe\x74\x09\x16\x07\x9b\x31\x29\xce\xaa\x00\x89\xb5\x2d\xe1\x15\x8d\x27\x2b\xe7\x5d\xab\xbb\x19\xdd\x23\xb3\xea\x9f\xb3\xd1\x17\xc5\x0b\xb8\xab\x1f\xfd\x6f\xca\x6e\x95\xb6\x08\x6e\xcd\xed\xa6\xe3\x53\x37\xc0\xee\xc1\xb7\x8c\x17\xf3\xdd\x26\x22\x91\x21\x3e\x55\xfb\x87\x87\xf2\x9b\x16\x75\x7d\xc2\xa7\xd8\x42\xb2\x13\x4a\x70\x8b\x66\x87\xc3\x21\x6a\x1d\xec\x28\x40\x58\x26\x45\x15\xf7\xbe\xa2\x38\x37\xb6\x00\xa5\x4d\xcb\xa4\x87\x20\x79\xb4\x8d\xe4\x71\x06\xbc\x7b\x34\x71\x2e\x83\xaf\xc2\x36\xd1\xf0\x71\x4c\x48\xb3\xa3\xce\x6d\xbd\xe3\xcd\x6e\x2a\x40\xc5\xc7\x31\x44\x86\xa9\x33\xc2\xf6\x8b\xb6\xd8\xfb\xda\x0e\x2a\xc9\xfa\xfe\x98\x7a\x09\x52\xea\x9c\xc5\xb6\x93\xcc\x4e\x83\xf8\x97\x14\x0a\xfb\x14\x02\x58\xf7\x01\xd6\x8b\x37\x3d\x80\x35\x7b\x10\xfc\x98\x3a\x37\x73\x49\xe9\x5b\xec\xdc\x44\xf3\x17\x2f\xc4\x38\x02\xe9\x3b\xa6\xe6\xee\x41\x9d\x74\xa6\xed\x33\xd4\xb9\xd0\x89\xe4\xcd\xfe\xf6\x96\xcf\x83\x45\xc5\x27\xee\x14\xa7\x05\x10\x06\x8d\xc1\xfa\x98\xde\x05\x84\x94\x92\x4a\x73\x9c\xe1\x42\x4c\x72\x46\x3f\xbe\xc3\x77\xd1\x43\xd4\xb0\xfd\xa4\xe5\xa5\x55\x01\x2a\x3c\x0c\xfe\xa1\x34\x94\xd8\x86\x4e\x19\x92\xdb\x26\x2c\x3f\xe3\x75\x89\x5f\xae\x1d\x2e\x8b\x40\x65\x5a\xe5\xd6\xd0\x5b\x96\xb7\xe0\x1e\x94\xbf\xdd\xf3\x0b\x6b\xf1\xe6\xae\x3e\x65\xb9\x4f\x7c\xc6\xeb\x38\xde\x6c\xc4\x59\xb9\x76\xbe\xfc\x3f\x2a\x2d\xa2\xc7\xfa\x04\xe0\x4d\xfa\xe8\x1c\xe1\xe2\xf5\x7d\xdf\xb8\x78\x9d\x11\xe2\xc1\xb5\xbc\x64\x2a\xf8\x58\xd2\x05\x00\x32\x7f\xc9\xc0\x62\x11\x61\xc2\x25\x79\xd4\x75\xad\xfd\xda\x85\xdf\x51\x32\x2b\xb4\x8a\x53\x49\x97\xf5\x34\x4d\xe4\x22\x57\xba\xae\xca\xa5\x78\x77\x7a\xa4\x58\xb3\xb8\xc8\xdb\xf5\xdc\xf8\x59\x5d\xda\xb9\x69\x88\xdf\x6f\xb8\x94\x49\xf1\x81\x85\x05\x38\xf7\xb7\x16\x0a\xb6\x7f\x30\x79\xc1\x1e\xd2\x0d\x84\x97\xf8\xff\xf3\x20\xf9\xf4\x56\x20\xf9\xf4\xbd\x88\x6e\xac\xbe\x2e\x51\xf1\x6f\x3c\xba\x17\x1b\xb8\x97\x50\x1c\x21\xa4\xbd\x1f\xce\x89\x1a\xee\xc5\x38\x92\x93\xa1\x0b\xd2\xbd\x1c\xc7\x37\x8f\x66\xbb\xff\x1d\x00\xc3\x80\x32\x41\xc0\x06\x00\x00")
+
+func templatesDocHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesDocHtml,
+		"templates/doc.html",
+	)
+}
+
+func templatesDocHtml() (*asset, error) {
+	bytes, err := templatesDocHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/doc.html", size: 1728, mode: os.FileMode(436), modTime: time.Unix(1792404072, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xab, 0xae, 0xfc, 0x45, 0x6c, 0x9, 0x22, 0x67, 0x4e, 0xb8, 0x9c, 0x8d, 0x5e, 0x99, 0x17, 0x4a, 0xfe, 0x72, 0x28, 0x56, 0xb9, 0xcb, 0x31, 0xb2, 0x4a, 0xef, 0x67, 0x7e, 0xee, 0x9e, 0x99, 0xcb}}
+	return a, nil
+}
+
+var _templatesDotTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x54\x5d\x6b\x1b\x3b\x10\x7d\xf7\xaf\x18\x94\xfb\x14\xd6\x9b\xe0\x0b\xf7\x86\x36\x1b\x28\x25\x2d\x81\xe2\x94\x36\x4f\x4d\x4b\xd0\x5a\xb3\x5e\x15\x59\x72\xa4\xd9\x84\x20\xf4\xdf\xcb\x6a\xbf\xbc\xc1\x59\x27\x7d\xf1\x8e\x35\x47\xe7\x68\x3e\xbd\x9f\x83\xc0\x42\x6a\x04\x26\x0c\x31\x98\x87\x30\x5b\x5b\xbe\x2d\xc1\xcf\x00\x00\x6a\xc0\x3f\x8a\x3f\x99\x8a\xe0\x5d\x06\xe9\x97\x68\x86\x10\x9d\x0d\xf0\x36\xda\x1d\x58\x16\x90\xde\x48\x52\xd8\xfc\x7e\x20\xb2\x32\xaf\x08\x5d\xaa\x78\x8e\x2a\x84\x1e\x1d\xff\x67\xe7\xe7\x9f\xae\x97\x37\xf0\xf5\xfa\x6a\x79\x33\xff\x7e\xf5\xe3\x32\x63\x8b\x53\x76\xe1\x7d\x49\x1b\x75\x80\xea\xfc\xa4\xbe\x7b\x71\x91\x8c\x39\x7f\x57\x8e\x32\xf5\xec\x50\x99\x55\x46\xc9\xe8\xa9\xa8\x45\x08\xa3\x13\xcb\xf5\x1a\xbb\x78\xd3\xcf\x75\x78\x23\x44\x1a\xc2\x4b\x14\xbf\xde\xc7\x8f\x36\x02\xe1\x76\x2c\x9d\xb1\x9f\x4b\x96\x4c\x08\x2d\x8d\xc0\x37\xea\xa0\x58\xef\xea\x08\x69\xb3\xdc\x50\x39\xa5\x72\x29\xd6\x6f\x55\xf1\x9e\x70\xb3\x55\x9c\x9a\xf6\xb8\xb3\xa8\x38\x49\xa3\x1d\x83\x34\x84\xbd\x10\xe2\xb9\xc2\x91\x7f\x0e\x2f\x90\x94\x72\x3b\x09\x74\x55\x4e\x4f\xdb\x69\x32\xd4\xd5\x66\x12\xa0\x0d\x4d\x33\x38\xaa\xf2\x49\xc0\xda\x9a\xaa\x7b\x68\x98\x79\x1f\xb3\xe4\x7d\x37\x36\xab\xb2\xce\xf2\xdd\xca\x28\x63\x59\x24\x69\xc7\x00\xef\x21\x05\xc6\x85\x40\xc1\x42\x38\x5a\xe0\xff\xe2\xdf\x85\xf7\xa8\x1c\x0e\x6e\x8b\x1b\xf3\xd0\x00\x56\xff\x2d\xce\x16\x67\x0d\x20\x84\x23\x2c\xce\x8a\xd3\xd3\x28\x17\xa7\xb2\x2d\x50\x6b\x77\xea\x5d\x32\xef\x1c\x3d\x29\x1c\xf4\x1f\x25\x95\x90\x7e\x6b\xbd\x3b\xa3\x13\xdf\x19\x42\x12\xbf\x99\xf7\xf7\x95\x21\xac\x63\x6b\x03\x3b\x74\x3f\xea\x84\x90\xc4\xef\x5f\xdc\xdf\xa2\x7e\x94\x82\xca\x10\x92\xce\xdc\xc7\x72\x20\xda\xba\xfd\x87\x60\x4f\x8e\x81\x4a\x6c\x86\xcd\xc1\x5a\x3e\xa0\x86\x12\x2d\x82\xc5\xad\xe2\x2b\x8c\xde\x15\xb7\x42\x6a\xae\x24\x49\x74\xe0\x90\x20\xc7\xc2\x58\x84\xe3\x93\x9e\x68\x28\xfc\xb3\xbc\x42\xda\x63\xda\xd2\xed\x4d\xad\x76\x64\xb9\xd4\x04\xac\xe0\xca\xd5\x2f\x4c\x86\xc3\x2c\x9e\xbd\x32\x4d\x25\x72\xd1\x6e\xb9\xa4\xb7\xdb\x65\xd9\x2f\xc7\x61\x03\xbe\x92\x95\xb8\x54\x1d\x6b\x6f\x1f\x66\x3d\x50\x8c\x66\x02\x46\xbd\x9f\x7e\x8c\x67\x7d\x9f\xb1\xdd\x35\x31\x9a\x98\x01\xca\x92\xc2\x68\x7a\x0b\xbe\x6f\xa0\xc5\x0c\x60\x54\x9d\x06\xb3\x3b\x5e\x6d\xc3\x0a\xee\x4a\x14\xe3\x74\xed\x9b\xb0\x3f\x03\x00\x22\x36\xee\x8d\x19\x07\x00\x00")
 
 func templatesDotTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -86,12 +117,72 @@ func templatesDotTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/dot.tmpl", size: 752, mode: os.FileMode(436), modTime: time.Unix(1571059917, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x82, 0x6f, 0x6d, 0x33, 0xa, 0x4f, 0x57, 0xe4, 0x65, 0xb4, 0xbb, 0xf4, 0xd9, 0xc1, 0x34, 0x16, 0xdc, 0x69, 0x7d, 0x32, 0x9, 0x7d, 0x46, 0xe9, 0x11, 0x7b, 0x46, 0x5a, 0x90, 0xd3, 0x7e, 0x63}}
+	info := bindataFileInfo{name: "templates/dot.tmpl", size: 1817, mode: os.FileMode(436), modTime: time.Unix(1792406078, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc, 0x17, 0x74, 0x2e, 0xaa, 0x1c, 0xd0, 0xd2, 0x33, 0x9c, 0xc1, 0xdf, 0x52, 0x94, 0xa7, 0x4a, 0x5f, 0x4a, 0x9d, 0x60, 0x9e, 0x7f, 0x86, 0xab, 0x6e, 0xe, 0x2a, 0x16, 0xe0, 0x64, 0x2, 0xac}}
+	return a, nil
+}
+
+var _templatesDot_enumsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x84\x90\x51\x4b\xc3\x30\x14\x85\xdf\xf7\x2b\x2e\xc1\xc7\x36\xeb\x1e\x7c\x99\xcd\xc0\xc9\x14\xa1\x0c\x91\xe2\x8b\x88\x64\xe6\x6e\x0d\xa6\x89\xb4\x19\xa2\x97\xfb\xdf\x25\x61\x6c\x43\x04\x9f\x5a\xc2\x39\x27\xdf\x17\x22\x83\x5b\xeb\x11\x84\x09\xf1\x15\xfd\xbe\x1f\x05\xf3\x84\xa8\x84\x8b\xb7\xe0\xc2\x00\x73\x05\xb2\xed\xb0\x47\xd9\xe8\x0d\xba\x9b\x74\x78\x48\x0c\xda\xef\x10\xe4\x2a\xb5\x98\x27\x00\x44\x72\xad\x7b\x64\x86\xe7\xb1\xd3\x1f\xa8\x7c\x88\x58\xf4\x7a\xd8\x59\xaf\x44\x25\x67\x45\x25\xab\x4b\x51\x6c\x83\x8f\xa3\xfd\x46\x35\xab\x0a\x97\x56\x55\x5d\x2f\x17\x44\x5d\xec\x1d\xc8\xd6\x46\x87\xcc\xf5\x74\xb9\x98\x00\x00\x9c\x5d\xf5\xa4\xdd\x1e\x47\xe6\x7a\xf9\x08\xd7\xcd\xfd\xdd\x5a\x89\x66\x75\xdb\x8a\xe9\xb1\xcc\x4c\x84\xde\xfc\x15\x39\xad\x7d\xda\xd8\x1d\xfc\x98\x8b\xfc\x55\x82\x48\x32\x8b\xdc\x86\x32\xeb\xbc\x5c\x65\xcd\x3c\xf7\x5b\xb8\xb1\xfe\xfd\x28\xdd\xea\x4d\x22\x26\xca\xc3\xf2\x21\x0c\x91\x79\x9e\x07\x0f\x34\x50\x96\x29\x98\x9a\xe9\x75\x8c\x1d\x94\x0f\x1e\x8b\x31\x7e\x39\x54\x26\xc4\x88\x86\xe8\x3f\x2e\xe6\x13\x52\x66\x3c\xff\xff\x19\x00\x89\x06\xcb\xc2\xcb\x01\x00\x00")
+
+func templatesDot_enumsTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesDot_enumsTmpl,
+		"templates/dot_enums.tmpl",
+	)
+}
+
+func templatesDot_enumsTmpl() (*asset, error) {
+	bytes, err := templatesDot_enumsTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/dot_enums.tmpl", size: 459, mode: os.FileMode(436), modTime: time.Unix(1792406078, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9f, 0xe0, 0x27, 0x87, 0xfa, 0x6d, 0x64, 0x56, 0xfa, 0x82, 0xb8, 0x91, 0xc5, 0xce, 0xeb, 0x11, 0x85, 0x80, 0xcc, 0xd1, 0xcc, 0x6d, 0x77, 0x37, 0x59, 0xca, 0x38, 0x9b, 0xa9, 0x67, 0xdd, 0x51}}
+	return a, nil
+}
+
+var _templatesDot_groupsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x7c\x91\xcd\x4a\xc3\x40\x10\x80\xef\x7d\x8a\x21\xf4\x68\x57\x04\x4f\xda\x14\x44\x54\x7a\x69\x05\x73\xf2\x52\x92\xee\x34\x59\x99\xee\xd6\xfd\x41\x65\x99\x77\x97\xed\xc6\xb6\x51\xea\x6d\xfe\xf2\xe5\x9b\xd9\x18\x25\x6e\x94\x46\x28\xa4\xf1\xab\xd6\x9a\xb0\x73\x05\xf3\x28\xc6\x09\x8c\xd7\x86\x8c\x85\x9b\x12\x44\xd5\xe1\x16\xc5\x53\x6a\xdf\xa7\x62\x3f\x61\x6b\xdd\x22\xe4\xba\x63\x1e\x01\xb8\xd0\xb4\xb6\xde\x75\xb0\xa6\xe0\x3c\xda\x55\x8c\x62\x51\x6f\x91\x19\xe2\x08\x00\x80\xea\x06\xa9\x9c\x4e\x1f\x97\x8b\x0a\x9e\x97\xf3\x45\x35\x79\x99\xbf\x3e\x94\xc5\xd5\x75\x31\x8b\xb1\xf3\x5b\x02\x51\x29\x4f\xc8\x3c\xbd\x4c\x53\xb3\xd9\xed\xf1\xcb\xb7\xe0\x7c\x49\xb9\x90\x0c\x3e\x94\xef\xc0\xd8\xde\xe1\xce\x7b\xab\x9a\xe0\xd1\x89\xec\x9e\x57\xd8\x9b\x01\xec\xe3\x32\xc6\xf7\x60\x3c\x82\x60\x3e\x62\x50\x4b\xe6\x43\xa6\x36\x7f\x79\x4d\x7b\x8a\x72\xfe\x8b\xb0\x2c\xac\x09\x5a\xa2\xbc\xd8\x28\x22\x94\x45\xe6\xa5\xe4\xd7\xaf\xce\xc2\x4e\x0c\xc8\xe1\x00\xde\xb3\xcf\x39\xf6\xa7\xaf\xea\x86\x30\x1d\xd8\x0d\xfd\x95\x96\xf8\x09\xe3\xdc\x77\x20\x0e\xdd\x7f\xd6\xfe\xc9\xf2\xdb\xa2\x96\x30\xe1\x61\xfc\x3d\x00\x11\x75\x8f\x36\x2e\x02\x00\x00")
+
+func templatesDot_groupsTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesDot_groupsTmpl,
+		"templates/dot_groups.tmpl",
+	)
+}
+
+func templatesDot_groupsTmpl() (*asset, error) {
+	bytes, err := templatesDot_groupsTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/dot_groups.tmpl", size: 558, mode: os.FileMode(436), modTime: time.Unix(1792406078, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x31, 0xe4, 0x24, 0x7, 0x57, 0x3f, 0x80, 0x36, 0xba, 0x12, 0xb, 0xbc, 0x3e, 0xa8, 0xb2, 0xfb, 0xf8, 0x18, 0x49, 0xaa, 0x9f, 0xd0, 0x88, 0x33, 0x19, 0x64, 0x75, 0x9e, 0xe1, 0x5, 0xaf, 0xc}}
 	return a, nil
 }
 
-var _templatesDot_relationsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x93\x4f\x4b\x33\x31\x10\x87\xef\xfb\x29\x86\x9c\xda\xf7\xed\xae\xdd\xb3\xfd\x83\x08\x82\x22\x15\x6a\x6f\x52\x24\x75\xa7\xdb\x40\x4c\x30\x19\x2d\x25\xe4\xbb\x4b\xd6\x75\x6b\x9b\x6d\x71\xbd\x0d\xc3\xf4\xf9\xa5\xf3\xec\x38\x57\xe0\x5a\x28\x04\x56\x68\x7a\x36\x28\x39\x09\xad\x2c\xf3\x3e\x71\xce\x70\x55\x22\x64\xf3\xef\xae\xf7\x09\x80\x73\xd9\x3d\xae\x69\xc1\x57\x12\x67\xfc\x15\xbd\x87\x34\x0d\xdd\xb9\x28\x37\x07\xed\xa7\x04\x20\xcc\xa7\x20\xd6\xd0\xc3\x37\xf8\x1a\xb9\xe6\xa6\x10\x8a\x4b\x41\x3b\x60\xff\x58\x1f\xd2\x8a\x0b\xc0\x8d\xd1\xdb\x0d\xf2\x62\xac\x5f\x8c\xde\x0e\x42\x29\xf9\x0a\xe5\x78\x34\xba\x79\x98\x2d\x26\xc3\x2c\x9b\x8d\x2e\xaa\x72\x32\x68\xe0\x28\x2d\x9e\x49\xf8\xcf\xfa\xed\x7c\x42\x8c\x23\xf2\xbf\x44\x4c\x3b\x45\xb8\xe1\x20\xf7\x27\x32\xe2\x55\x28\xad\x50\xb7\x73\x5c\xf4\x14\xdf\xc6\x55\x45\x83\xad\x5d\x34\x46\xaf\x88\x8c\x58\xbd\x13\xda\xac\x42\x37\x83\x51\xd0\x89\x1f\xfc\x32\xb0\xda\x5b\xf8\x6a\xce\xbb\x27\x2e\x64\xed\x3e\x94\x27\xdd\xb7\x7b\x89\xf8\x47\xe6\xf7\xf4\xb0\xce\x28\x20\xef\x1e\x30\xed\x14\x70\xe0\xfd\x8c\xf6\x0a\xd3\x68\x8f\x31\xee\xf8\x21\xbe\x85\xda\x38\x58\x5e\x26\x3f\x1b\xce\x85\xbf\x53\x12\xf4\x24\x2a\xc8\x6e\xad\xae\x6f\xbb\x0f\x39\xd4\xf7\x0d\x8f\x64\x84\x2a\xed\x9d\x16\x07\x23\xc0\xc2\xa5\xb3\x70\xd9\x96\x76\x12\xc7\x42\x7d\x08\xbb\x3c\xe2\xef\xeb\xcf\x00\x00\x00\xff\xff\x72\x2f\x28\xd9\x5c\x04\x00\x00")
+var _templatesDot_notesTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x91\x4f\x4b\xc4\x30\x10\xc5\xef\xfd\x14\x43\xe8\xb1\x8d\xdd\x83\x97\x75\xb3\xe0\x8a\x8a\x50\x8a\xc8\xde\x44\xa4\x6b\xa6\xdb\x40\x9a\x68\x1b\x11\x1d\xe6\xbb\x4b\x6b\xb6\xeb\x9f\x8b\xa7\x49\x87\xd7\xdf\x7b\x2f\x21\xd2\xd8\x18\x87\x20\xb4\x0f\x8f\xce\x07\x1c\x04\x73\x42\x94\x43\xfa\xe4\xad\xef\x61\xa9\x40\x6e\x5b\xec\x50\x96\xf5\x0e\xed\xc5\xb8\x8c\x8a\xbe\x76\x7b\x04\x59\xf9\x80\x95\xd7\x38\x30\x27\x00\x44\xb2\xaa\x3b\x64\x86\xfb\xa1\xad\x9f\x51\x8d\xd0\xac\xab\xfb\xbd\x71\x4a\x14\x72\x91\x15\xb2\x38\x15\x59\xe3\x5d\x18\xcc\x07\xaa\x45\x91\xd9\x91\xac\x56\x09\x00\xc0\x11\x9c\x9a\x0c\x52\x3b\x05\x28\x8d\x1b\xf1\x44\xa6\x81\xd4\x30\xaf\x36\x77\x70\x5e\xde\x5c\x57\x4a\x94\x97\x57\x5b\x71\xb2\x26\x42\xa7\x47\x45\x1b\x3a\x0b\xa9\x65\x8e\x9b\xbf\xd2\xf5\xec\x63\x1a\x90\xb1\x50\x36\xb5\x55\x44\x2f\xaf\x3e\xe0\xbc\x26\x42\x3b\xe0\x38\xdf\x4c\x68\xe3\x9d\xcc\x6a\x41\x24\x99\xc5\xec\x3d\x8d\x99\x3e\xfd\x21\x37\xfb\x83\xc3\x10\xde\x2d\xaa\xc6\x58\x8b\x3a\x1b\xc7\x2f\xcb\x48\x80\x7c\x62\x3c\x9c\x25\xc7\x90\xdb\x7a\x67\xf1\x70\xbd\xf1\x23\x46\x92\xb7\xbe\x0f\xcc\x4b\xa2\x03\x80\x19\xf2\xfc\xfb\x3b\x68\xd3\x2b\xe7\x1d\xc6\x04\xda\x87\x80\xfa\x3f\x8d\xe6\x0c\x5f\xc5\xe2\x09\xf2\x9f\xe7\xe4\x73\x00\xcb\x57\xa9\xe8\x45\x02\x00\x00")
+
+func templatesDot_notesTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesDot_notesTmpl,
+		"templates/dot_notes.tmpl",
+	)
+}
+
+func templatesDot_notesTmpl() (*asset, error) {
+	bytes, err := templatesDot_notesTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/dot_notes.tmpl", size: 581, mode: os.FileMode(436), modTime: time.Unix(1792406078, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xab, 0x6, 0xab, 0x1e, 0xc1, 0x38, 0xa6, 0xec, 0x7d, 0xd2, 0x3e, 0x89, 0x27, 0x12, 0x7a, 0x3b, 0xef, 0x32, 0x42, 0x98, 0xea, 0x6e, 0x4f, 0x23, 0x27, 0xf0, 0x60, 0x15, 0xa3, 0xd7, 0xcf, 0x5a}}
+	return a, nil
+}
+
+var _templatesDot_relationsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x94\xd1\x6b\xdb\x30\x10\xc6\xdf\xfd\x57\x1c\x62\x0f\xf1\x66\x6b\xf5\xf3\x92\x96\x31\x18\x74\x8c\x0c\xba\xbe\x8d\x52\x94\xea\x6c\x0b\x14\x69\x93\x6e\x2b\x41\xe8\x7f\x1f\x72\x5d\x37\xb1\x9d\x6c\xe9\xdb\x71\x7c\xfa\x7d\x87\xbe\xe3\x42\x90\x58\x2b\x83\xc0\xa4\xa5\x7b\x87\x5a\x90\xb2\xc6\xb3\x18\xb3\x10\x9c\x30\x0d\x02\xbf\x79\xee\xc6\x98\x01\x84\xc0\xbf\x62\x4d\xb7\x62\xa3\x71\x2d\xb6\x18\x23\x94\x65\xea\xde\xa8\xa6\x3d\x68\xff\xc8\x00\x92\xbe\x04\x55\xc3\x02\x7f\xc1\x93\xe4\x93\x70\x52\x19\xa1\x15\xed\x80\xbd\x65\x39\x94\x1d\x17\x40\x38\x67\x1f\x5b\x14\x72\x65\x1f\x9c\x7d\x2c\x52\xa9\xc5\x06\xf5\x6a\xb9\xfc\xfc\x6d\x7d\x7b\x79\xc1\xf9\x7a\xf9\xbe\x2b\x2f\x8b\x01\x8e\xda\xe3\x09\x87\x77\x2c\x9f\xe7\x13\xe2\xd4\xa2\x7a\x8d\xc5\xd5\x59\x16\xe1\xa2\xa8\xe2\x11\x8f\xe9\x57\x18\x6b\xd0\xce\x73\xc2\x64\x94\x38\xc7\x35\x72\xc0\xf6\x59\x0c\x89\x7e\x24\x72\x6a\xf3\x9b\xd0\xf3\x0e\x3d\x08\x47\x46\x2d\x6d\xf5\xf1\x57\xff\xe9\xda\x7d\x5e\x5a\x9d\xd3\x0b\x40\x42\xe9\x7e\x01\x52\x79\x74\x01\xe6\xc3\x99\xf0\x47\xf1\xbf\xd0\xd3\x9f\x4e\x0c\xaa\xf3\x0d\xae\xce\x32\x38\x08\xff\x44\xf6\x1d\x66\xc8\x7e\x8a\x09\xe3\x41\xe2\x0c\x75\x9a\x81\x30\x12\xde\xf0\x6b\x89\x86\x54\xbd\x53\xa6\x81\x85\xb1\x04\xfb\x9d\x3c\xc6\xc2\xd3\x4e\xe3\x4a\x0a\xdf\xa2\x0c\x61\xcc\x21\xdc\xfe\xd4\x82\x10\xd8\xf3\xbd\xb8\x47\xd9\x20\x03\xfe\x2f\xd9\x43\x2b\xcc\xbe\xf0\xee\x43\xb6\x3f\x68\x08\x69\xc6\x86\x60\xa1\xd1\x00\xbf\xf6\xb6\x3f\x3c\x39\x54\xd0\x1f\x1f\xf8\x4e\x4e\x99\xc6\x7f\xb1\xea\x40\x02\x0c\xca\x12\x58\x3a\x3b\x4f\xd3\x2b\xf3\x47\xf9\xbb\x11\xff\xa5\xfe\x3b\x00\xa0\x3d\x6d\xe4\xf9\x04\x00\x00")
 
 func templatesDot_relationsTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -106,12 +197,132 @@ func templatesDot_relationsTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/dot_relations.tmpl", size: 1116, mode: os.FileMode(436), modTime: time.Unix(1612438160, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6, 0xa9, 0xdd, 0x7c, 0xd2, 0x28, 0xd5, 0x41, 0x2a, 0xd9, 0x29, 0x8c, 0xb3, 0xe5, 0xe8, 0xf7, 0x65, 0xca, 0x26, 0x9d, 0x1b, 0xcc, 0xe5, 0xc0, 0x70, 0xa4, 0x4f, 0xf9, 0xf4, 0xf6, 0x78, 0xd2}}
+	info := bindataFileInfo{name: "templates/dot_relations.tmpl", size: 1273, mode: os.FileMode(436), modTime: time.Unix(1792406078, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf9, 0xab, 0x93, 0x3e, 0x43, 0x83, 0x3a, 0x48, 0x23, 0xaf, 0xc4, 0xe3, 0x42, 0xd2, 0x65, 0x94, 0x76, 0xb7, 0xd, 0xc7, 0xa6, 0xde, 0x84, 0xfc, 0x81, 0xfe, 0xc, 0x8a, 0xfa, 0x4, 0xaa, 0xf}}
+	return a, nil
+}
+
+var _templatesDot_relations_chenTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x94\x4d\x6b\x1b\x3d\x10\xc7\xef\xf9\x14\x83\x30\x0f\x76\x9e\x5d\xe7\x05\x7a\x69\xb2\x81\x52\x5a\x70\x69\x9d\x92\xe4\xd4\x12\x8c\xbc\x1a\xef\x0e\xc8\x92\x2b\xa9\x2d\x41\xe8\xbb\x17\xa9\xeb\x7d\x49\xd6\xce\xad\x17\x63\x46\xa3\x99\xbf\x7e\x33\xfb\xf7\x5e\xe0\x86\x14\x02\x2b\x6b\x54\xab\x92\x1b\x41\x8a\x4b\x72\x4f\x2c\x84\x13\x00\xef\x73\xa0\x0d\x4c\xb5\x81\x29\xfe\x80\x39\xb0\x53\x36\xdb\xff\xfd\x9f\xcd\x66\x90\xa7\xbc\x65\x93\x8b\xd2\x62\x13\xba\xd8\x87\x94\x48\x91\xe1\xff\x41\x5b\x14\x15\x76\xfd\x1c\x6e\x77\x92\x3b\x04\x66\x50\x72\x47\x5a\xad\xac\x7b\x92\xc8\x60\xde\xd7\x14\x35\xdc\x35\x09\xef\x9c\x33\xb4\xfe\xe9\xd0\xce\x4b\xad\xac\x33\x9c\x94\x03\xb6\xe1\xd2\xc6\xc2\x59\x17\x2c\x52\xcc\x7b\x54\xe2\x58\xc3\xb2\xe6\xaa\x6a\x3a\x8e\x0b\x17\xda\xad\xf6\xe9\x96\xa5\x23\x13\xef\xc0\x84\x32\x98\x18\x78\x5b\x74\xf2\x6c\xdb\xea\xec\x14\x90\x97\x35\xb4\x37\x6b\xda\x01\x59\xe0\x20\x88\x6f\xb5\x12\xa0\xb4\x40\x28\xb5\x52\x58\x3a\x14\xe0\x34\xac\xb5\xab\x01\x95\x23\x47\x68\xe1\xf4\x2c\x15\xeb\x17\x58\x79\x3f\xa1\x10\xe0\xbb\xad\xf9\x0e\x8b\xa6\x52\x96\xa0\x15\x56\x4b\x12\xd9\x96\x9b\x8a\x54\xc1\xce\xe7\xe7\x6f\xb2\xf8\xc3\x32\xc9\xd7\x28\x8b\xeb\xeb\x8f\xb7\xcb\x07\xf8\x7a\xbb\x58\x3e\xe4\xf7\x8b\x6f\x1f\x0a\x76\x71\xc9\x6e\x4e\x00\x5a\xd0\x63\x94\xd3\xe5\x10\xbc\xaf\xdd\x56\x1e\xcf\x88\x2b\x11\xc2\x7f\x6a\x6d\x77\x57\xde\xef\x41\x02\x5c\x9f\xc5\xce\x37\x37\xde\xc7\x1e\x0b\x11\x5f\xb8\x79\x22\x55\x85\x90\xed\xd0\xd0\xae\x46\x43\x68\x8b\xcb\x66\x58\xde\x1f\x5d\x0c\xef\x8f\x8f\xf1\xf1\x2a\x8d\x60\xfe\x19\x37\xee\x81\xaf\x25\x2e\xf9\x16\x43\x80\x3c\x1f\x67\x29\xc8\x14\x4a\x2b\x1c\x70\xea\xb8\xfc\x26\x57\x8f\xbe\xdb\x71\x92\xcf\xe8\x74\x14\xfa\x22\x5f\x7c\x70\x30\x31\x49\xdd\xfb\x2e\x16\xc2\x41\x62\x5c\x09\x98\xf4\xb1\xc1\x54\x69\x37\x00\x39\x0b\xa1\x59\x02\xc1\x6d\x8d\x62\x04\x64\xf7\xf9\xb5\x8c\xc6\x68\xe4\x79\x44\x77\x47\x55\x3d\x60\xf7\x1a\xa4\x03\xcb\x53\x23\x17\xaf\x2f\x50\x2f\xab\x2d\x98\xec\x25\xfa\x51\x7c\x7d\x72\xa1\xa4\xa9\x47\x2c\x1a\x54\x72\xa6\xbf\xc7\xcf\x78\xb6\xa7\x7b\xdf\x02\xf8\x32\x2c\x9e\xb7\xdd\x8e\x4d\xea\x45\xdb\xbe\xc6\x7f\x3a\xaf\xa1\x39\x45\x36\x95\x83\xa9\x44\x05\xf3\x85\xd5\xcd\x24\x67\x70\x01\x8d\x05\xc1\xbd\x33\xa4\x2a\xfb\x49\xd3\x20\x05\x58\x1c\x32\x4b\x2e\x92\x24\x90\xfa\x45\xf6\xf1\xe4\x90\x83\xff\x19\x00\x30\x93\x6f\x8b\x39\x06\x00\x00")
+
+func templatesDot_relations_chenTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesDot_relations_chenTmpl,
+		"templates/dot_relations_chen.tmpl",
+	)
+}
+
+func templatesDot_relations_chenTmpl() (*asset, error) {
+	bytes, err := templatesDot_relations_chenTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/dot_relations_chen.tmpl", size: 1593, mode: os.FileMode(436), modTime: time.Unix(1792406078, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1f, 0x6f, 0xe5, 0xba, 0x8e, 0xd1, 0x30, 0xa2, 0x57, 0x7f, 0xe4, 0x27, 0x35, 0xc0, 0x31, 0x92, 0xa1, 0xe9, 0x76, 0x3e, 0xe6, 0x1d, 0x29, 0xbd, 0x8, 0x9a, 0x9d, 0x0, 0xe9, 0xba, 0xd9, 0xda}}
+	return a, nil
+}
+
+var _templatesDot_relations_idef1xTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x84\x54\xd1\x6e\x13\x31\x10\x7c\xcf\x57\x8c\x2c\x1e\x92\x34\x77\x81\x67\x92\x22\x84\x84\x54\x84\x4a\x55\xfa\x14\x54\x55\x0e\xde\xbb\xb3\xf0\xd9\x60\x2f\xa0\x60\xf9\xdf\x91\xd3\xf6\x48\x72\x77\xe1\x29\xd6\xee\xec\xcc\x68\x27\xb7\x31\x2a\xaa\xb4\x25\x08\xe5\xf8\xc1\x93\x91\xac\x9d\x0d\x22\xa5\x49\x8c\x5e\xda\x9a\x50\xde\x3e\x57\x53\x9a\x00\x31\x96\x1f\xa9\xe2\x3b\xb9\x35\x74\x2d\x5b\x4a\x09\x45\x91\xab\xb7\xba\x6e\x8e\xca\x5f\x26\x40\xc6\x17\x58\xce\x21\x51\x69\x63\x48\x41\x39\x46\x2b\xfd\xb7\x00\x6e\x08\xad\xb4\x3b\x04\xad\x68\x81\x1b\x38\x4b\x70\x1e\xad\xf3\x04\x69\x15\x36\xf8\x43\xde\xe5\x52\xee\xcc\x97\x28\x52\xea\x38\x75\x85\x29\xfd\xc0\xa3\xec\x3b\xe9\x95\xb6\xd2\x68\xde\x41\xcc\xc5\xac\x43\x4a\xef\xdd\xef\x86\xa4\x5a\x2b\xc7\x8b\x6e\x98\x4c\xa0\x33\x0c\x17\xa3\x0c\xf9\x61\xe4\x96\xcc\x7a\xb5\x7a\xff\xe9\xfa\xee\xf2\x66\xb5\xdc\xff\x5e\x0e\x90\x3b\x3f\x26\xf0\x46\xcc\xc6\x5a\x2f\xc5\x6c\x48\xdc\x29\x2d\x5b\x67\x55\xdf\xc1\x66\xc4\x41\x9f\xc3\x3a\x4b\x07\x20\xab\x4e\x17\xda\x45\xfd\x96\xd9\xeb\xed\x4f\xa6\x50\xee\xb5\x3a\xe0\x91\x72\x8c\x0d\xb7\x66\x7c\x2a\xa5\x01\x6b\x7d\xd5\xfd\x22\xf2\x7f\xea\x7c\x8a\x2c\xb5\xc9\x19\x0c\x87\xd8\x9b\xbf\x18\x9b\x5f\xe4\xc7\x70\x86\xe3\x11\xf6\xe8\xbb\x04\x7b\x9d\x7e\x80\x7b\xe5\x2e\xc0\x9e\xfc\x66\x58\xbe\x4f\x91\xf3\x3b\xb7\xc8\xfc\xcd\xbc\x28\xaf\x14\x59\xd6\xd5\x4e\xdb\x1a\x53\xeb\x18\x87\x95\x59\x4a\x8b\xc0\x3b\x43\x6b\x25\x43\x43\x2a\xc6\x53\x1e\xa6\xf6\xbb\x91\x4c\x10\xcf\xd7\xe0\x81\x54\x4d\x02\xe5\xff\x60\x5f\x1b\x69\x0f\x81\xf7\xaf\x27\x87\x46\x63\xcc\x1e\x6b\xc6\xd4\x90\x45\x79\x15\xdc\xd3\x59\x99\xe1\x15\x9e\x4e\x0b\x3e\xb3\xd7\xb6\x0e\x1f\x9c\x3e\x82\x40\xa0\x28\x20\xf2\x51\x79\x74\xaf\xed\x2f\x1d\xee\x4f\xf8\xff\xbd\xff\x0e\x00\x0c\x78\x74\x82\xd7\x04\x00\x00")
+
+func templatesDot_relations_idef1xTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesDot_relations_idef1xTmpl,
+		"templates/dot_relations_idef1x.tmpl",
+	)
+}
+
+func templatesDot_relations_idef1xTmpl() (*asset, error) {
+	bytes, err := templatesDot_relations_idef1xTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/dot_relations_idef1x.tmpl", size: 1239, mode: os.FileMode(436), modTime: time.Unix(1792406078, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xed, 0x6, 0xdc, 0xdc, 0x24, 0x64, 0xba, 0xe4, 0x26, 0x59, 0xda, 0x72, 0xb0, 0x2b, 0xdf, 0xc5, 0x54, 0xb9, 0xb2, 0xc3, 0xb, 0x15, 0x9e, 0x52, 0x80, 0x8c, 0x86, 0x38, 0x94, 0xd0, 0xa6, 0x2}}
 	return a, nil
 }
 
-var _templatesDot_tablesTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x52\x5d\x8f\x93\x40\x14\x7d\xef\xaf\xb8\xb9\xd9\xf8\xd4\x96\xae\x56\x1f\x5c\x86\x84\xaf\xee\x92\x20\x34\xec\x44\x13\x8d\x31\x50\xa6\x0d\x71\x16\x0c\xcc\x9a\x6c\x46\xfe\xbb\x61\x3a\x60\x41\xfc\x8a\x7d\x29\xf7\x70\x3f\x0e\xe7\x1c\x29\x73\x76\x2c\x4a\x06\x98\x57\xe2\x93\x48\x33\xce\x1a\x6c\xdb\x85\x94\x75\x5a\x9e\x18\x5c\x89\xcf\x4b\xb8\x12\xf0\x9a\xc0\x9a\xaa\xb7\x6d\xbb\x00\x90\x72\x1d\xa5\x0f\xac\x6d\xe1\x03\x4f\x33\xc6\x89\x69\x52\xdb\x09\xfd\x05\xa8\x9f\x13\x27\x9e\x9f\x10\xdc\xa0\x06\x5c\x3f\x0c\xf7\xb6\xe7\x05\xd1\xed\x04\xbd\xdf\xdb\xee\x19\x5d\xbf\xec\xf1\x77\x81\x47\xef\x08\x5e\xbf\xd8\xf6\x88\x1d\x06\xb7\x11\x41\xd7\x8f\xa8\x9f\xf4\xa0\xa5\xff\x4d\x9a\xf4\x8f\x5d\xe1\x4d\xba\xe1\xad\xae\x9d\x98\xd2\xf8\x0d\x5e\xae\xff\x31\x07\x60\xee\xe2\x88\xc2\x3e\x0e\x22\xba\xba\x0f\xde\xfb\x04\xaf\xb7\x08\x3b\xdb\xf5\x09\xde\x31\xfe\x95\x89\xe2\x90\x42\x56\xf1\x1c\x2d\xd3\xb1\xa4\x5c\xd3\x42\x70\xd6\xb6\xa6\xe1\x58\xa6\xd1\x4d\x5f\xae\x93\x72\x05\xc5\x51\xab\x66\x0b\x51\x17\xd9\xa3\x60\xcd\x5a\xe9\x05\x2b\x25\xe3\xf4\xf8\xf9\x98\x5d\x17\x29\x87\x40\xa4\xbc\x38\xe0\x98\xd0\x06\xc1\x8d\xc3\x38\x21\x78\xaa\xd9\xd3\xab\x0d\x5a\xcf\xca\xac\xf9\x72\xd3\x91\x99\xbb\xd3\x91\x9b\x23\xc6\xca\x7c\x44\xc1\x34\xa8\x37\xc8\x69\xf4\x7a\x9a\x86\x32\xf5\x5c\xf4\xdf\xe3\x56\xfc\xf1\xa1\x6c\x86\xf1\x6f\xe7\xd6\xdf\xda\xaf\x0d\x08\xfd\x1d\xfd\x87\x44\x6c\x67\xf2\xd0\x93\xec\xd8\xe8\x84\x76\x01\x3d\xa8\x80\x6a\x6a\xc3\x77\xfd\x2a\x18\x8a\x87\x35\xe3\xf7\x73\x1c\xd9\x3a\x56\x6e\x2c\xc0\x1f\x1c\xfd\x7f\x3f\xe7\xcf\xcc\xd3\xfa\x3b\x3b\x87\x5e\xdd\xf9\x93\xbd\x7a\xcd\xd8\xef\x69\xae\xb2\xd3\xa1\xe2\x55\xad\x77\x2c\x8f\x05\xe7\x0a\x20\x38\x13\xc2\xa1\x19\x97\xaa\xbd\x11\x4f\x9c\x91\x6e\x86\xe5\xd3\xb3\xaa\xfe\x78\xb3\xb8\x84\x2e\x9f\xbf\x07\x00\x00\xff\xff\x3c\x8a\xab\x86\xab\x04\x00\x00")
+var _templatesDot_relations_minmaxTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x53\x4f\x8b\xdb\x4e\x0c\xbd\xe7\x53\x3c\xcc\xef\x10\xe7\xe7\x38\x9b\x73\x93\x2d\xa5\x50\xd8\x52\x52\xd8\xee\xad\x2c\x61\x92\x91\x6d\xd1\xf1\x4c\xea\xd1\x36\x09\xc6\xdf\xbd\x78\x6c\xe7\xcf\x92\x1c\xea\x93\x78\x7a\xa3\xa7\x27\xc9\x75\xad\x29\x63\x4b\x88\x4a\xb6\xa5\x3a\xac\x77\xaa\x12\xde\xf2\x4e\x09\x3b\x1b\x35\xcd\x08\xa8\xeb\x29\x38\xc3\x98\x7e\x23\x45\x34\x89\x62\x4c\x03\x3e\x7e\x48\x56\x71\x9f\x27\xe3\xe9\x82\xf4\xff\x99\x34\xbf\x41\x72\xd5\x40\xfc\x18\xc5\x43\xf8\x10\xc5\x17\x95\xe7\x57\x8f\x4e\xb5\xce\xb0\xd5\x01\xbd\x8e\x07\x33\xda\xc9\xba\x22\x13\x4c\xf8\x28\xa4\x2a\x65\x73\x42\xfa\x3c\xa0\xbd\xb5\xf4\x1b\x65\xf2\xa2\x36\x86\x56\xaa\xa4\xa6\xc1\x74\xda\xa2\xcf\x9c\x17\x57\xf0\xcf\x11\xd0\x29\xcf\x26\x18\x97\x6c\x93\x52\x1d\x62\x6c\xdd\x9b\x15\x8f\xc2\xed\xe1\x32\x21\x0b\x29\x08\x64\x85\xe5\x08\x4b\x07\x81\x38\xb0\x40\xd4\x2f\xf2\x68\x67\x0b\xee\x38\x43\x77\x49\xa8\xdb\x7f\xde\x81\xd4\xb6\x08\x86\x7c\xe1\xf6\x3e\x50\xb7\xaa\xd2\x6c\x95\x69\x6b\xee\x2b\x96\x56\xc6\x75\x55\xdc\x6e\xe7\x3c\x0b\xc1\xb3\x26\x4c\x66\xfd\xa0\x00\x55\x55\x6e\x5f\x90\xd2\x4b\xeb\x2c\x25\x6d\x64\xd4\x86\xcc\x72\xb1\xf8\xf2\x7d\xf5\xf2\x58\xd7\x42\xe5\xce\x28\xb9\xb7\x79\x84\xc1\x7c\x3e\x4b\x37\xcd\x62\x16\x9e\x3e\x26\xa7\x51\x70\x76\x1e\xe8\x27\x91\x8a\x37\x6f\x42\x3e\x0d\x4a\xa7\x56\xde\xe9\x16\x52\x9a\xfb\xaf\x6e\xa9\x0c\xeb\x3d\xf9\x12\xc5\xa6\xf3\xd5\x46\xff\xea\x2b\xac\xf6\xa6\xb1\xba\xe6\x0c\xca\x6a\xfc\x97\x3e\xe9\x76\x89\xd9\x91\x6d\x8e\xb1\x75\x82\x4b\x24\x6e\x9a\xc4\xcb\xd1\xd0\x52\x2b\x5f\x90\xae\x6b\xb2\xba\x69\x2e\xb5\x87\xf5\xae\x49\xe7\x14\x21\xbd\x93\xdd\x16\xca\xf6\xf9\xd7\x0f\xef\x6e\xb9\x9d\x6e\x2e\x18\x1b\xb2\x48\x9f\xbc\xeb\xef\x36\xc6\x1c\xfd\xed\xe2\x87\x54\x6c\x73\xff\xd5\xf1\x15\x05\x51\x7b\xc5\x51\x7b\xb5\x5d\x9b\x6c\xff\xb0\x7f\x1d\xdd\xfb\x6f\xfe\x0e\x00\x09\x1b\xb1\x67\x05\x04\x00\x00")
+
+func templatesDot_relations_minmaxTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesDot_relations_minmaxTmpl,
+		"templates/dot_relations_minmax.tmpl",
+	)
+}
+
+func templatesDot_relations_minmaxTmpl() (*asset, error) {
+	bytes, err := templatesDot_relations_minmaxTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/dot_relations_minmax.tmpl", size: 1029, mode: os.FileMode(436), modTime: time.Unix(1792406078, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6c, 0x69, 0x42, 0x49, 0x7a, 0x4b, 0x7f, 0x1d, 0x73, 0x2f, 0x3f, 0x68, 0x33, 0x3e, 0x18, 0xdc, 0x4b, 0x59, 0xcc, 0x63, 0xee, 0x83, 0x41, 0x2c, 0xce, 0x91, 0xcf, 0x6f, 0x33, 0x9d, 0x76, 0xc2}}
+	return a, nil
+}
+
+var _templatesDot_relations_umlTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x52\x4d\x6b\xdb\x40\x10\xbd\xe7\x57\x0c\x4b\x0f\x96\x6b\xc9\xf1\xb9\x76\x4a\x29\x14\x52\xd2\x14\xd2\xf4\x54\x82\x59\x7b\x47\xd2\xc0\x6a\xd6\xd5\x8e\x1b\xcc\xb2\xff\xbd\xe8\xb3\x72\xb0\x0f\xbd\x0d\x6f\x66\xe7\xbd\xb7\xf3\x42\x30\x98\x13\x23\xa8\x63\x65\xb7\xd5\xd1\x0a\x1d\x2c\xed\x49\x4e\x2a\xc6\x1b\x80\x10\x52\xa0\x1c\x66\xf8\x1b\x32\x50\x73\x95\x40\xda\xe2\xb7\x59\x36\xef\xdb\x68\x3d\x4e\x66\xde\x8f\x33\xab\x0b\x33\xae\x1e\xe6\x3e\xaa\x64\x28\x6f\x55\x32\xd9\xbb\x9a\xbe\x49\x7b\x15\xd9\xa8\x06\xd9\xb4\xe8\x79\x3d\xb8\x30\x4e\xb6\x35\x5a\x2d\xe4\xd8\xab\xb6\x55\x6b\x2e\x10\xb2\xa7\x01\x1d\x36\x3e\x60\x2e\xcf\x7a\x67\xf1\x51\x57\x18\x23\xa4\x69\x83\x3e\x51\x51\x9e\xc1\xbf\x6e\x00\x3a\xe6\xe5\x1c\x7e\x7e\x7b\x00\xed\xbd\xdb\x53\xb7\x0b\x74\x8d\x70\xb0\x9a\x18\x2c\x31\x7a\x78\x25\x29\x61\xf2\x8d\x84\x1e\xb4\xc0\xce\x49\xd9\xa8\xf5\x30\x5f\xf6\x9e\x00\x74\x5d\xbb\xd7\x12\xb5\xd9\xb0\x63\x5c\x34\x95\xd5\x3b\xb4\x9b\xf5\xfa\xcb\xf7\xc7\xe7\xbb\x10\x04\xab\x83\xd5\x72\xe9\x3a\xd0\x29\xfd\xac\x6b\x43\xac\x2d\xc9\x29\xc6\xf5\xb2\x7d\x77\xb7\x18\x25\x53\xfe\xcf\xf8\x27\x91\x9a\x76\x47\x41\x9f\xb5\x34\xa3\x8e\x37\xa4\xa5\x54\xf6\xfa\xab\x4b\x2c\xc3\x19\x46\x53\xa2\xc9\x76\xa6\x9a\xea\x7f\x4c\x35\x47\xb9\xe8\x29\x04\xca\x41\xb3\x81\x77\xd9\xbd\x41\x16\xca\x4f\xc4\x05\xcc\xd8\x09\x4c\x91\x24\xc6\x85\x97\x93\xc5\x8d\xd1\xbe\x44\x13\x02\xb2\x89\x71\x4a\x3b\xe4\x63\x8b\xa6\x40\x05\xd9\x95\xee\xbe\xd4\xdc\xf7\x5f\x3e\xbc\x89\x5b\xf3\xb1\x85\xc0\xcc\x22\x43\x76\xef\x5d\x1f\xad\x04\x56\xd0\xc7\x0b\x7e\x48\x4d\x5c\xf8\xaf\x8e\xce\x46\x40\x35\x41\x53\x4d\xb0\x3a\x99\xc4\x7f\xc8\xbf\xdc\x5c\x8b\xf6\xdf\x01\x00\x17\x48\x0e\xa3\xa1\x03\x00\x00")
+
+func templatesDot_relations_umlTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesDot_relations_umlTmpl,
+		"templates/dot_relations_uml.tmpl",
+	)
+}
+
+func templatesDot_relations_umlTmpl() (*asset, error) {
+	bytes, err := templatesDot_relations_umlTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/dot_relations_uml.tmpl", size: 929, mode: os.FileMode(436), modTime: time.Unix(1792406078, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8a, 0x93, 0x4e, 0xdd, 0xcb, 0xba, 0x85, 0x76, 0x8a, 0x6d, 0x1d, 0xc6, 0xc8, 0x8f, 0x57, 0xd7, 0x56, 0xca, 0x6a, 0x1e, 0x7a, 0x85, 0xc4, 0xd5, 0xf3, 0x2f, 0x31, 0xd4, 0x16, 0xd5, 0xf8, 0xfc}}
+	return a, nil
+}
+
+var _templatesDot_relationshipsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x84\x53\x4d\x6f\xdb\x30\x0c\xbd\xfb\x57\x10\x42\x0e\xc9\x66\xab\xce\x61\x97\xcd\xce\xb0\x06\x1b\x50\xa0\x48\x8a\xcc\xa7\x0d\x43\xa1\xc4\x4c\x2c\x4c\x96\x52\x59\xc1\x50\x08\xfa\xef\x83\xe4\x8f\xd6\x0d\xd2\x5c\x6c\x4a\x24\xdf\xe3\x23\x29\x6b\x4b\xdc\x73\x89\x40\x4a\x65\x1e\x35\x0a\x66\xb8\x92\x4d\xc5\x8f\x0d\x71\x2e\xb2\x36\x81\x89\xa9\xb0\x46\xf8\x9c\x03\x2d\xbc\xd5\x5d\x6b\x26\x0f\x08\x74\xf3\x3a\xc5\xb9\x08\xc0\x5a\xba\x62\x3e\x0c\x7e\x37\x15\x3b\x62\x5e\x72\x56\x2b\x59\xc6\x35\xd3\x07\x2e\x73\x92\xd2\xf4\x53\xec\x3f\x24\x16\x6c\x8b\x22\xcf\x22\x00\x9f\x97\x00\xdf\x8f\x11\xbf\x19\xa3\xf9\xf6\x64\xb0\xa1\x21\xd4\x39\x6b\x2b\x53\x8b\xeb\x51\x28\x1a\x7c\x89\x2e\xb8\x11\xe1\x88\xb2\x74\x6e\xa0\xeb\x34\x2c\x95\x38\xd5\xb2\x71\x2e\xbb\xdd\xdc\x2c\xb2\x1f\xeb\x55\x01\x0f\xeb\xbb\x55\x91\xfc\xbc\xfb\xf5\x3d\x27\xf3\x94\x58\xfb\x8f\x9b\xaa\xeb\x05\xbd\xf7\x2c\x4b\x25\x94\x76\x0e\x96\xeb\xfb\xf5\x26\x27\xd6\x52\xe7\x48\xc7\xb0\x78\x43\x9c\xdd\x78\xd0\x45\xef\x1d\x0a\x08\xa0\x97\xb4\xec\x5a\x82\x38\xfc\x73\x6b\x9f\x4e\xca\x20\xd0\x73\x19\xef\x74\x6d\x7b\xe8\x51\xf6\x5c\x88\x37\x48\x57\x73\x1a\xf3\x2c\x30\xf7\x99\x58\x0e\x6c\xbe\xb3\x9e\xb2\xeb\x45\xc1\xb6\x02\x6f\xd9\xee\xef\x41\xab\x93\x2c\x47\x4c\xc4\xda\x4b\x51\x64\x04\x1e\x14\x41\x12\x34\xfd\xf9\x12\xb5\x44\x13\xc9\xba\xbd\x6b\xf7\xa9\xbf\x0e\xd8\xe1\xfe\xfd\xc6\x45\xa3\x21\x3f\x30\x6d\xf8\x8e\x1f\x99\x34\xc3\x9e\x86\xaa\x5a\x70\x48\x12\xb0\x76\x22\xbb\xcd\x2d\xb9\xce\xa5\x92\xd8\x6f\x68\xd6\xcd\xcf\x60\x7d\x14\xcc\x20\x90\xd7\x6f\xe5\x71\xc7\x74\xc9\x25\x13\xdc\x3c\x13\xa0\xcb\x97\xd3\x30\xfa\x45\xbf\x41\xd7\xa6\x3a\xc8\x6f\x67\xdc\x59\x90\x9c\xd9\xfd\xc3\xbd\x58\xc8\xd0\x01\xbe\x07\x7c\x02\x0a\x64\x4e\x9c\x9b\xce\xe3\xf9\xac\x7d\x1f\xde\xa1\x34\x4c\x5b\xe7\x57\x32\xeb\xcd\x94\xcc\x9c\x9b\xa6\xa3\xc0\xd6\xf3\x81\x04\xc7\xea\xcc\xf1\xb1\x85\xee\x1d\x5e\x4e\xaf\xe9\xac\xf6\xff\x03\x00\x3e\x4b\x52\xbd\x75\x04\x00\x00")
+
+func templatesDot_relationshipsTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesDot_relationshipsTmpl,
+		"templates/dot_relationships.tmpl",
+	)
+}
+
+func templatesDot_relationshipsTmpl() (*asset, error) {
+	bytes, err := templatesDot_relationshipsTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/dot_relationships.tmpl", size: 1141, mode: os.FileMode(436), modTime: time.Unix(1792406078, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x83, 0xb9, 0x66, 0x96, 0x24, 0x76, 0xd8, 0x1d, 0x4d, 0x99, 0x2c, 0x22, 0x1b, 0xfa, 0x43, 0xf3, 0x69, 0x21, 0x8d, 0xb5, 0x4f, 0x44, 0xd4, 0xb1, 0x6, 0x4d, 0x33, 0x0, 0x0, 0xfe, 0x20, 0x4c}}
+	return a, nil
+}
+
+var _templatesDot_subtypesTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x4c\x90\xbf\x4e\xc3\x30\x10\xc6\xf7\x3c\xc5\xc9\x73\x12\x04\x12\x13\x71\x97\x0a\xc6\x32\xd0\x0d\xa1\xca\x89\xaf\xc4\xc2\xb1\x23\xfb\x50\x28\xa7\x7b\x77\x94\xa4\xa5\xdd\xbe\x3f\xd6\xa7\x9f\x8f\xd9\xe2\xd1\x05\x04\x65\x23\x1d\xf2\x77\x4b\xa7\x11\xb3\x12\x29\x98\x2b\x48\x26\x7c\x22\xd4\x7b\xd3\x7a\xcc\x22\x05\xc0\x9c\xba\x23\xd4\xcf\x3f\x84\xc1\x5e\xb2\xab\x85\xaa\x9a\xfd\xce\x0c\x28\x02\xef\xd6\x25\xdd\x9a\xee\xab\x34\x29\xc5\x89\x8c\xf3\x1a\x87\x91\x4e\xab\xcf\xee\x17\xf5\x7d\xfd\x58\x00\xac\xcb\x93\xa3\x1e\xea\xb7\x95\x62\x1b\x43\xa6\x64\x5c\xa0\x2c\x52\x7a\xd3\xa2\xd7\x4d\xf3\xf2\xba\xdb\x6f\x98\x6b\x91\xe6\x6e\xd1\x1b\x66\x0c\x16\x2a\x91\xff\x99\x19\x70\xdb\xcf\xec\x22\x65\x17\x7d\x4c\x5a\x31\x13\x0e\xa3\x37\x84\xa0\xba\xa5\x3b\x2c\x8d\xba\x3e\x55\xe5\x88\x61\x72\x96\x7a\xfd\x70\x3b\xfa\xf1\x74\xfe\x38\x06\x7b\x3e\xcc\xa5\xbc\xd5\x7f\x03\x00\x21\xe0\x80\xf2\x4b\x01\x00\x00")
+
+func templatesDot_subtypesTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesDot_subtypesTmpl,
+		"templates/dot_subtypes.tmpl",
+	)
+}
+
+func templatesDot_subtypesTmpl() (*asset, error) {
+	bytes, err := templatesDot_subtypesTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/dot_subtypes.tmpl", size: 331, mode: os.FileMode(436), modTime: time.Unix(1792405859, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xdc, 0xf8, 0xdb, 0x63, 0xed, 0xe6, 0x13, 0xad, 0x99, 0x8f, 0x1e, 0x8a, 0xfd, 0xda, 0xc5, 0xb0, 0x5d, 0x1e, 0xe9, 0x64, 0xfb, 0x52, 0xd3, 0x14, 0xec, 0x2a, 0x17, 0x4f, 0x7b, 0xe3, 0x4c, 0xd5}}
+	return a, nil
+}
+
+var _templatesDot_tablesTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x56\xeb\x8a\xdb\x46\x14\xfe\xaf\xa7\x18\x06\x53\x12\xf0\x4a\x49\x9a\xfe\x69\x24\x81\xaf\xbb\x06\xc5\x36\xb6\x48\xa1\xa5\x2c\xba\x8c\xd7\x62\xc7\x1a\x47\x1a\x37\x09\xd3\x81\x3e\x4d\x1f\xac\x4f\x52\xe6\xa6\x9b\x25\x2f\x85\x52\xea\x3f\x96\xce\x9c\xcb\x9c\xcb\xf7\xe9\x30\x96\xa2\x43\x96\x23\x00\x53\x42\x1f\x69\x14\x63\x54\x42\xce\x2d\xc6\xee\xc0\xe8\x40\x72\x0a\x7e\xf4\x80\xbd\x24\x39\x35\x42\x7a\x44\x27\x24\xa5\xa1\x78\x32\xe2\x33\x29\x68\x29\xc5\x41\x96\x3f\xa3\x74\x2b\xde\xe5\x61\x11\xe5\x4f\x08\x8c\xe8\xf3\x18\x8c\x94\xbb\x50\x86\xe1\xdc\x02\x80\x31\x7b\x1d\x09\x2f\xe0\x17\x1c\xc5\x08\x7b\xae\x1b\x4e\xa6\xc1\xc2\x02\xf2\x37\xdd\xec\xe6\x8b\x9d\x07\xdf\x40\x2d\x98\x2d\x82\x60\x3b\x99\xcf\x57\xeb\xfb\x8e\x74\xbf\x9d\xcc\x94\xd4\xfe\xc1\xc8\x7f\x5a\xcd\xc3\x07\x0f\xbe\xfd\xfe\xbd\x91\x4c\x82\xd5\xfd\xda\x83\xb3\xc5\x3a\x5c\xec\x8c\xd0\xd7\xff\x6e\xb8\x33\x8f\xe2\x65\xde\xd1\x06\x9f\xf4\xfb\x74\x13\x86\x9b\x8f\xb0\xe9\xbe\xb6\x03\xc0\x5d\x6e\xd6\x21\xd8\x6e\x56\xeb\xf0\x6e\xbf\xfa\x79\xe1\xc1\xb7\xef\x21\x58\x4e\x66\x0b\x0f\x32\x46\x0a\x5d\x58\x55\x49\xfb\x01\x45\x29\x2a\x54\x85\x61\xc3\x0b\x00\xa2\xae\xd9\x01\xd8\xb3\xa3\xa8\x20\xe7\x60\xb6\x09\x36\x3b\xe1\x83\xa2\xd3\x19\x47\x14\x01\x98\xc8\xb3\xc7\x84\x60\x52\xc0\x5a\x15\x32\x86\x70\x89\x84\x79\x2b\xcc\x4c\xe8\x35\x3d\xf5\x9d\x0a\xe3\x3c\xe5\xdc\xef\xbb\x0d\xfa\x6c\xa2\x00\x58\xa0\x13\xf9\x0d\xa5\x90\x73\x77\xef\xbb\x53\x9f\xb1\x23\x3d\x61\x60\x87\x19\xc5\x88\x73\xd7\x99\xfa\xae\xb3\xf7\xd5\x5d\x38\xef\xd7\x90\xc1\xc0\x9d\x9c\x06\xf3\x73\x1d\x51\x42\xdf\xba\x8a\xae\x46\x67\x42\x69\x91\xc5\x17\x8a\x4a\x5b\x0e\x4d\xc7\xda\x74\x60\xa8\xe2\x81\xb0\xd1\x05\x6f\xb7\xe9\x0d\x64\xec\x4b\x46\x8f\x2d\xcd\xab\x9a\xd9\x8d\x12\x7d\x97\xc7\xe5\xf9\x03\x63\xd9\x41\xc5\xe0\xdc\x5d\xd5\x59\xf6\x5d\x56\x64\xbd\xaa\x6a\xf2\x82\xaa\x0e\xd3\x5f\x8f\x6e\xdd\x5c\x27\x9c\x57\xa3\xec\x98\x59\x76\x1d\x09\x28\xdf\x32\x66\xa3\x84\xe0\xcb\x29\x57\x60\xfd\x94\x95\x59\x8c\xd1\x4c\x8b\x46\xb6\x7a\xfa\x48\x52\x54\xf9\xd6\xb5\xaf\xec\x8c\xfc\x77\xe5\xff\x26\x5e\x35\x62\x82\xc5\x32\xfc\x07\x10\x7e\xdf\x03\x60\x93\x99\xb8\x8d\xa6\x14\xc1\x28\x89\x48\xc3\x5c\x8d\xf3\x17\x90\x2c\xef\xa1\x7b\xac\x53\x6d\x14\x3d\x7e\x4a\x74\xaf\xa7\xf7\x55\xb7\x55\x7f\xea\x96\xcb\x5e\x67\x79\x8a\xbe\x1a\xd2\x1b\x51\xc9\x61\xc0\x16\x9c\xc7\x39\xd8\x6e\x76\xa1\x9c\x13\xf5\x5e\xcd\x4a\x0f\x2f\xbc\x83\xd6\xbf\x83\xf7\xee\x68\x68\xf0\x5f\xe7\x98\x74\xa7\x59\xe5\x37\xa4\x37\xe4\x58\x03\x44\x99\x0d\xb1\x4a\xeb\xb4\x8f\x55\x84\xc7\x81\x5e\x64\x09\xc9\x6b\x78\x70\x6e\x80\x26\x7d\x74\x5c\x34\xca\x23\xdd\x3c\x52\x41\x2f\x10\xd8\x1d\x78\xb4\x41\x54\x95\xbb\x1b\xba\x8f\x53\xfe\x27\x8c\xd2\x7f\xd7\x7e\x4a\x19\xd2\x1d\xe0\x94\x97\x18\xa5\xe6\x01\x6a\x3f\x44\x65\xf8\xed\x8c\x4a\xce\x07\x41\xe6\x1b\x94\x09\xc5\x40\xdf\xf2\x3f\x2b\x62\x3d\x37\x3a\xcd\x2a\xe9\x6e\x42\xcd\x79\xaa\x49\xb3\x7b\x76\x45\xa2\xba\x52\xbe\x75\xeb\xe3\x54\xf1\x89\xd4\x1a\x1f\x32\x8c\xa5\xc0\x63\xec\xf3\x85\x50\x74\xc3\x62\x2c\x4d\x4a\xfa\x0d\x23\x4f\xd8\xa1\xd4\x1a\x80\xa0\x74\x31\x8d\x92\xe7\xa7\x82\x5c\xf2\xf4\x3a\x58\x8d\xc7\x2b\x55\x78\x2b\x4c\x63\x16\xba\xcc\xa4\x42\x54\xee\x5f\xa6\x27\x15\xe7\x8c\xf2\x2f\x59\x4a\x8f\xde\xbb\xde\x28\xbf\x7e\xb0\x9a\xa2\xf6\xb3\x59\x53\x5b\x08\xd7\x2b\xe4\x1d\x18\x95\xb4\xc8\x9e\xe5\x5a\x4a\x0a\xf0\xaa\x6f\x49\x79\x0d\x5e\xd9\x4b\x1c\x3d\x01\xa8\x74\xe1\xeb\xca\x5a\xa4\xa6\x8e\x62\x82\xe5\x3a\x33\xf5\x9b\x6c\xaf\x0f\x33\x1a\xe1\x2c\x81\x1a\x90\xf5\xb1\x0e\x2e\xb7\xa0\x9a\xa0\x84\xe3\xd6\xb2\x53\x07\xab\x0d\x9c\xfd\xed\x40\xce\xaa\xef\xdc\xdc\xd2\xa9\xae\x39\x50\x2c\xb1\xd3\x97\xf4\x12\xd7\x2b\xbd\xec\x4c\xbd\xbd\xb7\xa0\x64\xd5\x9f\x56\x7b\x2f\xac\xfa\x37\x74\xf8\xd7\x1f\x7f\xc2\x31\x25\x04\xd3\xec\xdc\x18\x65\x95\xe5\xb8\x3c\x46\x67\xe4\xc5\xe4\xeb\x58\x8d\x15\x94\xb3\x86\xd2\x71\x1a\x95\x47\x94\x42\xab\x45\xfc\x23\x33\xef\xd5\x30\x09\x18\x8f\x05\x2f\xb4\x24\xcd\x2d\xf1\xc6\x9c\xfc\x3d\x00\x9b\x75\x7e\x05\xcf\x0c\x00\x00")
 
 func templatesDot_tablesTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -126,8 +337,28 @@ func templatesDot_tablesTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/dot_tables.tmpl", size: 1195, mode: os.FileMode(436), modTime: time.Unix(1612404317, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa0, 0xfb, 0x63, 0x46, 0x83, 0x6b, 0x2c, 0xfe, 0x10, 0x5, 0xa0, 0xcc, 0xe2, 0x41, 0xe7, 0xcc, 0x43, 0x75, 0xcd, 0xeb, 0x89, 0xd3, 0x5e, 0x1, 0xdc, 0xd8, 0xe0, 0x4b, 0x60, 0x74, 0x3c, 0xc4}}
+	info := bindataFileInfo{name: "templates/dot_tables.tmpl", size: 3279, mode: os.FileMode(436), modTime: time.Unix(1792406078, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xbe, 0x9, 0xa, 0x2, 0xf4, 0xca, 0x35, 0x88, 0xf2, 0x11, 0x1c, 0xa5, 0x8b, 0x30, 0x50, 0x37, 0x4c, 0x5e, 0xb8, 0x81, 0x64, 0xdf, 0xa1, 0x42, 0xc0, 0xca, 0x42, 0xfa, 0x4a, 0x34, 0xef, 0xc4}}
+	return a, nil
+}
+
+var _templatesPreviewHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x54\x51\x6f\xdb\x36\x10\x7e\xd7\xaf\xb8\x29\xe8\x10\x03\xb5\xec\x2c\xc9\xd0\x48\xb2\x80\x2d\x0d\xd6\x01\xed\x36\xa0\xc1\x80\x3d\x0d\xb4\x78\x92\x89\x49\xa4\x40\x9e\x14\x67\x1c\xff\xfb\x40\x51\x8a\x55\xb7\x7d\x6a\xf2\x40\x99\x77\xfc\xbe\xef\xee\x3e\xd2\x5a\x8e\x95\x90\x08\x71\xa7\x71\x10\xf8\x14\x3b\x97\x7f\xf7\xf6\xf7\xfb\xc7\xbf\xfe\x78\x80\x03\xb5\x4d\x11\xe5\xf3\x82\x8c\x17\x51\xde\x22\x31\x28\x0f\x4c\x1b\xa4\x5d\xdc\x53\xb5\x7e\x13\x17\x51\x4e\x82\x1a\x2c\xac\x4d\x1e\xfd\x87\x73\xb0\x06\xd4\x7c\x5d\xab\x7c\x13\x42\x51\x6e\xe8\xd9\xaf\x00\x7b\xc5\x9f\xc1\x42\xcb\x74\x2d\x64\x0a\xdb\x0c\x2a\x25\x69\x5d\xb1\x56\x34\xcf\x29\xbc\xc3\x66\x40\x12\x25\x7b\x0d\x3f\x69\xc1\x9a\xd7\x60\x98\x34\x6b\x83\x5a\x54\x19\xb8\x08\xc0\x4b\x41\x0d\x16\x3a\xc6\xb9\x90\x75\x0a\x3f\x76\x47\xb8\xfa\xa1\x3b\x66\xb0\x67\xe5\x3f\xb5\x56\xbd\xe4\x29\x5c\x54\x37\xfe\x3f\x83\xbd\xd2\x1c\xf5\x7a\xaf\x88\x54\x9b\xc2\x55\x77\x04\xa3\x1a\xc1\xe1\x82\x73\x3e\xd1\x1b\xf1\x2f\xa6\x70\x75\xed\x41\x4a\xd5\x28\x9d\xc2\xc5\xed\xed\x6d\x60\xbc\xe0\x82\xd5\x9a\xb5\x4b\xce\xc0\xa7\x06\xd4\x55\xa3\x9e\x52\x60\x3d\xa9\xb3\x6c\x33\xd4\x63\xa1\xc7\xf5\x93\xe0\x74\x48\xe1\x6a\xbb\x7d\x95\xc1\x01\x45\x7d\xa0\xe5\x89\x04\xb5\x56\x7a\xd1\x14\x0f\xee\x3b\xf3\xc2\xf6\xe6\x54\x61\x28\xa6\xc1\x8a\x52\xb8\x59\x94\x72\x7d\x7d\x5e\x3e\xaf\xb6\xd5\xf6\x13\x86\x4e\xe3\x82\xc5\xb7\x6d\x0b\x73\x46\x23\x24\x4a\x05\xf6\xa5\xfc\xbb\xbb\xbb\x10\xea\x34\x26\x46\xf5\xba\x44\xb0\xe7\xcd\xc9\x37\xd3\x60\xf3\xcd\x64\x11\x3f\xdf\xc9\x30\xa8\x97\x9e\xf8\xbe\x15\x9c\x2b\xca\x20\x37\x1d\x93\x20\xf8\x2e\x36\xc4\xa8\x37\x71\xd1\x88\x01\xf3\x8d\xdf\x2e\x02\x0e\xea\x22\xca\xb9\x18\xc6\xac\xa9\x9f\x71\x61\x2d\x61\xdb\x35\x8c\x4e\x96\xfd\x7b\x0e\x42\xe2\x5c\xbe\xe1\x62\xf0\x5e\x2b\xb5\xe8\xc8\x9b\x6d\x60\x1a\x70\x40\x49\x06\x76\x20\xf1\x09\x1e\xfc\x8f\x8f\x63\x31\x97\x71\x88\xc4\xab\x2c\x82\x29\x2b\x51\xb2\x45\x63\x58\x8d\xb0\x83\xaa\x97\x25\x09\x25\xe1\x72\x05\x36\x02\x00\xa8\x90\xca\xc3\xe5\x8b\xa0\x55\x42\x07\x94\x97\xa7\x3c\xbd\x02\x0b\x1a\xa9\xd7\x12\x74\x42\x78\xa4\xcb\x55\x06\xee\xb3\x3c\x7f\xa9\x66\x4c\x00\xae\xca\xbe\x45\x49\x49\x8d\xf4\xd0\xa0\xff\xfc\xf9\xf9\x57\xbe\xe4\x11\x52\xa2\x7e\xf7\xf8\xe1\x3d\xec\xc6\x8b\x99\x8d\x47\xdd\xa8\xdc\x7d\x22\x5f\x75\x28\xcf\xb5\x7f\x9d\x61\x1a\xc0\x6a\xd4\x7a\xaf\x24\xa1\x24\xd8\x41\xec\x07\x12\x67\x67\xd0\xc1\x43\xdf\x8c\xcd\x85\x29\x95\x94\x58\x12\xf2\xc0\x91\x6f\xe6\x89\xe5\x9b\xc9\x3e\x9b\xf0\xee\x58\x8b\x92\x3b\x17\x7d\xf6\x54\xbd\xcc\x7d\x0c\xae\x41\x33\x59\x23\x24\x0f\x5e\xa2\x71\x2e\x98\xa7\x6c\x98\x31\xbb\x78\xd4\x1d\x7b\x3b\xe4\x7b\x6f\xc8\xdf\x58\x8b\xce\xa5\xd6\x26\xef\x85\x9c\xbe\xee\x55\xd3\xb7\xd2\x5b\x68\x5f\x80\xb5\xc9\x87\xe0\x02\xe7\xfd\xef\xf1\x45\x05\xc9\x23\x1e\x69\xdc\xc8\x3b\x8d\x45\x70\xf1\x44\x11\x2e\x8f\x77\x68\xa7\x85\xa4\x0a\xe2\x57\x37\x3c\x86\x89\x00\xfe\x9b\xcc\xed\x91\x27\x94\x2f\x1e\x07\xff\xb7\x48\x2e\x99\x46\x0a\xc4\xb0\x90\xe8\xe9\x27\x5d\xa1\x3d\x93\xf1\x4f\x1b\xb3\xe4\xb1\x1f\x5f\x6e\x87\xb5\x73\xf4\xab\xa7\x3f\xfe\xf9\x8b\x73\xd6\xce\x2b\x36\x06\xc7\xfd\xb7\x8a\x9c\xf3\x4d\x98\x21\xc3\xeb\x30\x62\x86\xd8\x28\xf1\x34\xbc\xb0\xfe\x3f\x00\x1b\xe8\x96\x71\x70\x06\x00\x00")
+
+func templatesPreviewHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesPreviewHtml,
+		"templates/preview.html",
+	)
+}
+
+func templatesPreviewHtml() (*asset, error) {
+	bytes, err := templatesPreviewHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/preview.html", size: 1648, mode: os.FileMode(436), modTime: time.Unix(1792400497, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb9, 0x22, 0x8c, 0x32, 0x8d, 0x7a, 0xb2, 0x72, 0xc1, 0xa9, 0xdf, 0x65, 0xa3, 0xc4, 0x4f, 0xe8, 0x8b, 0x30, 0x69, 0x5b, 0x2a, 0xe3, 0x8a, 0x25, 0xac, 0xc, 0x51, 0xba, 0xe0, 0x5b, 0x52, 0x77}}
 	return a, nil
 }
 
@@ -222,11 +453,33 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
+	"templates/doc.html": templatesDocHtml,
+
 	"templates/dot.tmpl": templatesDotTmpl,
 
+	"templates/dot_enums.tmpl": templatesDot_enumsTmpl,
+
+	"templates/dot_groups.tmpl": templatesDot_groupsTmpl,
+
+	"templates/dot_notes.tmpl": templatesDot_notesTmpl,
+
 	"templates/dot_relations.tmpl": templatesDot_relationsTmpl,
 
+	"templates/dot_relations_chen.tmpl": templatesDot_relations_chenTmpl,
+
+	"templates/dot_relations_idef1x.tmpl": templatesDot_relations_idef1xTmpl,
+
+	"templates/dot_relations_minmax.tmpl": templatesDot_relations_minmaxTmpl,
+
+	"templates/dot_relations_uml.tmpl": templatesDot_relations_umlTmpl,
+
+	"templates/dot_relationships.tmpl": templatesDot_relationshipsTmpl,
+
+	"templates/dot_subtypes.tmpl": templatesDot_subtypesTmpl,
+
 	"templates/dot_tables.tmpl": templatesDot_tablesTmpl,
+
+	"templates/preview.html": templatesPreviewHtml,
 }
 
 // AssetDir returns the file names below a certain
@@ -271,9 +524,20 @@ type bintree struct {
 
 var _bintree = &bintree{nil, map[string]*bintree{
 	"templates": &bintree{nil, map[string]*bintree{
-		"dot.tmpl":           &bintree{templatesDotTmpl, map[string]*bintree{}},
-		"dot_relations.tmpl": &bintree{templatesDot_relationsTmpl, map[string]*bintree{}},
-		"dot_tables.tmpl":    &bintree{templatesDot_tablesTmpl, map[string]*bintree{}},
+		"doc.html":                  &bintree{templatesDocHtml, map[string]*bintree{}},
+		"dot.tmpl":                  &bintree{templatesDotTmpl, map[string]*bintree{}},
+		"dot_enums.tmpl":            &bintree{templatesDot_enumsTmpl, map[string]*bintree{}},
+		"dot_groups.tmpl":           &bintree{templatesDot_groupsTmpl, map[string]*bintree{}},
+		"dot_notes.tmpl":            &bintree{templatesDot_notesTmpl, map[string]*bintree{}},
+		"dot_relations.tmpl":        &bintree{templatesDot_relationsTmpl, map[string]*bintree{}},
+		"dot_relations_chen.tmpl":   &bintree{templatesDot_relations_chenTmpl, map[string]*bintree{}},
+		"dot_relations_idef1x.tmpl": &bintree{templatesDot_relations_idef1xTmpl, map[string]*bintree{}},
+		"dot_relations_minmax.tmpl": &bintree{templatesDot_relations_minmaxTmpl, map[string]*bintree{}},
+		"dot_relations_uml.tmpl":    &bintree{templatesDot_relations_umlTmpl, map[string]*bintree{}},
+		"dot_relationships.tmpl":    &bintree{templatesDot_relationshipsTmpl, map[string]*bintree{}},
+		"dot_subtypes.tmpl":         &bintree{templatesDot_subtypesTmpl, map[string]*bintree{}},
+		"dot_tables.tmpl":           &bintree{templatesDot_tablesTmpl, map[string]*bintree{}},
+		"preview.html":              &bintree{templatesPreviewHtml, map[string]*bintree{}},
 	}},
 }}
 
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Theme is the colors and fonts of a diagram. Empty values keep the
+// defaults of Graphviz.
+type Theme struct {
+	Background      string `json:"background"`       // of the diagram
+	TitleColor      string `json:"title_color"`      // of the title and group names
+	HeaderColor     string `json:"header_color"`     // of the table names
+	HeaderFont      string `json:"header_font"`      // of the table names
+	ColumnColor     string `json:"column_color"`     // of the column names
+	LabelColor      string `json:"label_color"`      // of the table and column labels
+	LabelFont       string `json:"label_font"`       // of the table and column labels
+	TableBackground string `json:"table_background"` // unless set by bgcolor
+	BorderColor     string `json:"border_color"`     // of the tables
+	EdgeColor       string `json:"edge_color"`       // of the relations
+	EdgeLabelColor  string `json:"edge_label_color"` // of the cardinalities and relation labels
+	GroupColor      string `json:"group_color"`      // of the group borders, unless set by color
+	Font            string `json:"font"`             // of everything else
+}
+
+// themes are the built-in themes
+var themes = map[string]Theme{
+	"default": {
+		HeaderFont: "Helvetica bold",
+		LabelColor: "grey60",
+		LabelFont:  "Arial Italic",
+		GroupColor: "grey60",
+	},
+	"dark": {
+		Background:      "#1e1e1e",
+		TitleColor:      "#e0e0e0",
+		HeaderColor:     "#ffffff",
+		HeaderFont:      "Helvetica bold",
+		ColumnColor:     "#d4d4d4",
+		LabelColor:      "#8a8a8a",
+		LabelFont:       "Arial Italic",
+		TableBackground: "#2d2d2d",
+		BorderColor:     "#6a6a6a",
+		EdgeColor:       "#9e9e9e",
+		EdgeLabelColor:  "#c8c8c8",
+		GroupColor:      "#6a6a6a",
+	},
+	"monochrome": {
+		TitleColor:     "#000000",
+		HeaderColor:    "#000000",
+		HeaderFont:     "Helvetica bold",
+		ColumnColor:    "#333333",
+		LabelColor:     "#777777",
+		LabelFont:      "Arial Italic",
+		BorderColor:    "#000000",
+		EdgeColor:      "#555555",
+		EdgeLabelColor: "#333333",
+		GroupColor:     "#999999",
+	},
+	"print": {
+		Background:      "white",
+		TitleColor:      "black",
+		HeaderColor:     "black",
+		HeaderFont:      "Times-Bold",
+		ColumnColor:     "black",
+		LabelColor:      "grey40",
+		LabelFont:       "Times-Italic",
+		TableBackground: "white",
+		BorderColor:     "black",
+		EdgeColor:       "black",
+		EdgeLabelColor:  "black",
+		GroupColor:      "grey40",
+		Font:            "Times-Roman",
+	},
+	"high-contrast": {
+		Background:      "black",
+		TitleColor:      "white",
+		HeaderColor:     "yellow",
+		HeaderFont:      "Helvetica bold",
+		ColumnColor:     "white",
+		LabelColor:      "cyan",
+		LabelFont:       "Arial Italic",
+		TableBackground: "black",
+		BorderColor:     "white",
+		EdgeColor:       "white",
+		EdgeLabelColor:  "yellow",
+		GroupColor:      "white",
+	},
+}
+
+// themeNames returns the names of the built-in themes, sorted
+func themeNames() []string {
+	var names []string
+	for name := range themes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// isThemeFile reports whether a theme name refers to a file
+func isThemeFile(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".json", ".yaml", ".yml":
+		return true
+	}
+	return false
+}
+
+// loadTheme returns a built-in theme, or the theme of a JSON or YAML file.
+// The keys a file leaves out are those of the default theme.
+func loadTheme(name string) (*Theme, error) {
+	if !isThemeFile(name) {
+		t, ok := themes[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown theme %q, want one of %s or a .json or .yaml file",
+				name, strings.Join(themeNames(), ", "))
+		}
+		return &t, nil
+	}
+
+	body, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	if ext := strings.ToLower(filepath.Ext(name)); ext == ".yaml" || ext == ".yml" {
+		values, err := parseFlatYAML(string(body))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		body, _ = json.Marshal(values)
+	}
+	t := themes["default"]
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.DisallowUnknownFields()
+	err = dec.Decode(&t)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	return &t, nil
+}
+
+// parseFlatYAML parses the YAML of a theme file, a mapping of keys to
+// plain or quoted scalars
+func parseFlatYAML(source string) (map[string]string, error) {
+	values := map[string]string{}
+	for i, line := range strings.Split(source, "\n") {
+		line = strings.TrimRight(line, "\r")
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || trimmed == "---" {
+			continue
+		}
+		if line[0] == ' ' || line[0] == '\t' {
+			return nil, fmt.Errorf("line %d: nested values are not supported", i+1)
+		}
+		colon := strings.Index(trimmed, ":")
+		if colon < 0 {
+			return nil, fmt.Errorf("line %d: want key: value", i+1)
+		}
+		key, value := strings.TrimSpace(trimmed[:colon]), strings.TrimSpace(trimmed[colon+1:])
+		if strings.HasPrefix(value, `"`) || strings.HasPrefix(value, "'") {
+			scalar, rest, err := quotedScalar(value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", i+1, err)
+			}
+			if rest = strings.TrimSpace(rest); rest != "" && !strings.HasPrefix(rest, "#") {
+				return nil, fmt.Errorf("line %d: unexpected %q after the string", i+1, rest)
+			}
+			value = scalar
+		} else if j := strings.Index(value, " #"); j >= 0 {
+			value = strings.TrimSpace(value[:j])
+		}
+		values[key] = value
+	}
+	return values, nil
+}
+
+// quotedScalar splits a double or single quoted YAML string from the rest of
+// the line
+func quotedScalar(s string) (string, string, error) {
+	quote := s[0]
+	for i := 1; i < len(s); i++ {
+		switch {
+		case quote == '"' && s[i] == '\\':
+			i++
+		case s[i] == quote && quote == '\'' && i+1 < len(s) && s[i+1] == '\'':
+			i++
+		case s[i] == quote:
+			if quote == '\'' {
+				return strings.Replace(s[1:i], "''", "'", -1), s[i+1:], nil
+			}
+			unquoted, err := strconv.Unquote(s[:i+1])
+			return unquoted, s[i+1:], err
+		}
+	}
+	return "", "", fmt.Errorf("unterminated string")
+}
+
+// Theme returns the theme of the ERD, the default theme unless set
+func (e *Erd) Theme() *Theme {
+	if e.theme == nil {
+		t := themes["default"]
+		return &t
+	}
+	return e.theme
+}
+
+// layout returns the attributes the theme sets in the graph, node and edge
+// blocks
+func (t *Theme) layout() map[string]string {
+	attrs := map[string]string{}
+	add := func(key, value string) {
+		if value != "" {
+			attrs[key] = value
+		}
+	}
+	add("graph.bgcolor", t.Background)
+	add("graph.fontcolor", t.TitleColor)
+	add("fontname", t.Font)
+	add("node.color", t.BorderColor)
+	add("edge.color", t.EdgeColor)
+	add("edge.fontcolor", t.EdgeLabelColor)
+	return attrs
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadTheme(t *testing.T) {
+	dir, err := ioutil.TempDir("", "erd-themes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	files := map[string]string{
+		"ocean.json": `{"background": "#002b36", "header_color": "#93a1a1"}`,
+		"ocean.yaml": "# ocean\nbackground: \"#002b36\"\nheader_color: '#93a1a1' # headers\n",
+		"typo.json":  `{"backgrund": "#002b36"}`,
+		"nested.yml": "colors:\n  background: black\n",
+	}
+	for name, body := range files {
+		err = ioutil.WriteFile(filepath.Join(dir, name), []byte(body), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name string
+		want Theme
+		err  string
+	}{
+		{name: "dark", want: themes["dark"]},
+		{name: "sepia", err: `unknown theme "sepia"`},
+		{name: filepath.Join(dir, "ocean.json"), want: Theme{
+			Background:  "#002b36",
+			HeaderColor: "#93a1a1",
+			HeaderFont:  "Helvetica bold",
+			LabelColor:  "grey60",
+			LabelFont:   "Arial Italic",
+			GroupColor:  "grey60",
+		}},
+		{name: filepath.Join(dir, "ocean.yaml"), want: Theme{
+			Background:  "#002b36",
+			HeaderColor: "#93a1a1",
+			HeaderFont:  "Helvetica bold",
+			LabelColor:  "grey60",
+			LabelFont:   "Arial Italic",
+			GroupColor:  "grey60",
+		}},
+		{name: filepath.Join(dir, "typo.json"), err: `unknown field "backgrund"`},
+		{name: filepath.Join(dir, "nested.yml"), err: "line 2: nested values are not supported"},
+	}
+	for _, tt := range tests {
+		theme, err := loadTheme(tt.name)
+		if tt.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("%s\ngot: %v\nwant: %v", tt.name, err, tt.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *theme != tt.want {
+			t.Errorf("%s\ngot: %+v\nwant: %+v", tt.name, *theme, tt.want)
+		}
+	}
+}
+
+func TestFiltered_theme(t *testing.T) {
+	saved := opts
+	defer func() { opts = saved }()
+
+	erd, err := parseErd("title {theme: print}\ngraph {edge.color: red}\n[a]\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, flag := range []string{"", "high-contrast"} {
+		opts.Theme = flag
+		e, err := filtered(erd)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := themes["print"]
+		if flag != "" {
+			want = themes[flag]
+		}
+		if *e.Theme() != want {
+			t.Errorf("--theme %q\ngot: %+v\nwant: %+v", flag, *e.Theme(), want)
+		}
+		// the graph directive wins over the theme
+		for _, a := range e.Layout().Edge {
+			if a.Key == "color" && a.Value != "red" {
+				t.Errorf("got: %v\nwant: %v", a.Value, "red")
+			}
+		}
+	}
+}
@@ -0,0 +1,329 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Problem is an error or warning found by Validate
+type Problem struct {
+	Begin   Position
+	End     Position
+	Warning bool
+	Message string
+
+	table string // the unknown table of a reference
+}
+
+func (p Problem) String() string {
+	if p.Warning {
+		return fmt.Sprintf("%v: warning: %s", p.Begin, p.Message)
+	}
+	return fmt.Sprintf("%v: %s", p.Begin, p.Message)
+}
+
+// problemAt creates a Problem covering the node
+func problemAt(n *SyntaxNode, warning bool, format string, args ...interface{}) Problem {
+	return Problem{Begin: n.Begin, End: n.End, Warning: warning, Message: fmt.Sprintf(format, args...)}
+}
+
+// tableDefinitions returns the name nodes of the tables, by table name
+func tableDefinitions(f *SyntaxFile) map[string]*SyntaxNode {
+	tables := map[string]*SyntaxNode{}
+	for _, n := range f.Statements {
+		if n.Kind == SyntaxTable {
+			name := n.Child(SyntaxName)
+			if _, ok := tables[replaceAllIllegal(name.Text)]; !ok {
+				tables[replaceAllIllegal(name.Text)] = name
+			}
+		}
+	}
+	return tables
+}
+
+// tableNodes returns the table statements, by table name
+func tableNodes(f *SyntaxFile) map[string]*SyntaxNode {
+	tables := map[string]*SyntaxNode{}
+	for _, n := range f.Statements {
+		if n.Kind == SyntaxTable {
+			name := replaceAllIllegal(n.Child(SyntaxName).Text)
+			if _, ok := tables[name]; !ok {
+				tables[name] = n
+			}
+		}
+	}
+	return tables
+}
+
+// separatedColumns reports the columns separated from their table or
+// relationship by a blank line
+func separatedColumns(n *SyntaxNode, kind string) []Problem {
+	var problems []Problem
+	blank := false
+	for _, c := range n.Children {
+		switch c.Kind {
+		case SyntaxBlank:
+			blank = true
+		case SyntaxColumn:
+			if blank {
+				problems = append(problems, problemAt(c, false,
+					"column %q is separated from its %s by a blank line", c.Child(SyntaxName).Text, kind))
+			}
+		}
+	}
+	return problems
+}
+
+// extendsItself reports whether a table is its own supertype, directly or
+// through the tables it extends
+func extendsItself(n *SyntaxNode, tables map[string]*SyntaxNode) bool {
+	name := replaceAllIllegal(n.Child(SyntaxName).Text)
+	for i := 0; i <= len(tables); i++ {
+		parent := n.Child(SyntaxReference)
+		if parent == nil {
+			return false
+		}
+		if replaceAllIllegal(parent.Text) == name {
+			return true
+		}
+		if n = tables[replaceAllIllegal(parent.Text)]; n == nil {
+			return false
+		}
+	}
+	return false
+}
+
+// definedTwice reports the attributes of a column setting what its
+// definition already sets, like a type attribute after the type
+func definedTwice(n *SyntaxNode) []Problem {
+	defined := map[string]bool{}
+	if n.Child(SyntaxType) != nil {
+		defined["type"] = true
+	}
+	for _, c := range n.ChildrenOf(SyntaxConstraint) {
+		key := strings.Fields(c.Text)[0]
+		if key == "not" {
+			key = "null"
+		}
+		defined[key] = true
+	}
+	var problems []Problem
+	for _, a := range n.ChildrenOf(SyntaxAttribute) {
+		if key := a.Child(SyntaxKey); defined[key.Text] {
+			problems = append(problems, problemAt(key, true,
+				"%s is already set by the definition of column %q", key.Text, n.Child(SyntaxName).Text))
+		}
+	}
+	return problems
+}
+
+// checkAttach checks the attach attribute of a note names a table, or a
+// column as table.column. Unknown tables are only warnings, as they may be
+// defined in another file.
+func checkAttach(n *SyntaxNode, tables map[string]*SyntaxNode) (Problem, bool) {
+	for _, a := range n.ChildrenOf(SyntaxAttribute) {
+		if a.Child(SyntaxKey).Text != "attach" {
+			continue
+		}
+		attach := a.Child(SyntaxValue)
+		if _, ok := tables[replaceAllIllegal(attach.Text)]; ok {
+			return Problem{}, true
+		}
+		i := strings.LastIndex(attach.Text, ".")
+		if i < 0 {
+			return problemAt(attach, true, "unknown table %q", attach.Text), false
+		}
+		table, ok := tables[replaceAllIllegal(attach.Text[:i])]
+		if !ok {
+			return problemAt(attach, true, "unknown table %q", attach.Text[:i]), false
+		}
+		for _, c := range table.ChildrenOf(SyntaxColumn) {
+			if columnName(c.Child(SyntaxName).Text) == attach.Text[i+1:] {
+				return Problem{}, true
+			}
+		}
+		return problemAt(attach, true, "table %q has no column %q", attach.Text[:i], attach.Text[i+1:]), false
+	}
+	return Problem{}, true
+}
+
+// identifying reports whether a relation is written == or has the
+// identifying attribute
+func identifying(n *SyntaxNode) bool {
+	if n.Child(SyntaxOperator).Text == "==" {
+		return true
+	}
+	for _, a := range n.ChildrenOf(SyntaxAttribute) {
+		if a.Child(SyntaxKey).Text == "identifying" && isTrue(a.Child(SyntaxValue).Text) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkIdentifying checks the child of an identifying relation, the table on
+// the many side, has a *+ column referring to the primary key of the parent.
+// Tables defined in other files are not checked.
+func checkIdentifying(n *SyntaxNode, tables map[string]*SyntaxNode) (Problem, bool) {
+	if !identifying(n) {
+		return Problem{}, true
+	}
+	refs, cards := n.ChildrenOf(SyntaxReference), n.ChildrenOf(SyntaxCardinality)
+	var candidates [][2]*SyntaxNode // child, parent
+	switch {
+	case isMany(cards[0].Text) && isMany(cards[1].Text):
+		return problemAt(n, true, "a many-to-many relation cannot be identifying"), false
+	case isMany(cards[0].Text):
+		candidates = [][2]*SyntaxNode{{refs[0], refs[1]}}
+	case isMany(cards[1].Text):
+		candidates = [][2]*SyntaxNode{{refs[1], refs[0]}}
+	default:
+		candidates = [][2]*SyntaxNode{{refs[1], refs[0]}, {refs[0], refs[1]}}
+	}
+
+	for _, c := range candidates {
+		child, ok := tables[replaceAllIllegal(c[0].Text)]
+		if !ok {
+			return Problem{}, true
+		}
+		var keys []string
+		if parent, ok := tables[replaceAllIllegal(c[1].Text)]; ok {
+			for _, col := range parent.ChildrenOf(SyntaxColumn) {
+				if title := col.Child(SyntaxName).Text; strings.Contains(columnKey(title), "primary") {
+					keys = append(keys, columnName(title))
+				}
+			}
+		}
+		for _, col := range child.ChildrenOf(SyntaxColumn) {
+			title := col.Child(SyntaxName).Text
+			if columnKey(title) != "primary, foreign" {
+				continue
+			}
+			if keys == nil {
+				return Problem{}, true
+			}
+			for _, k := range keys {
+				if name := columnName(title); name == k || name == c[1].Text+"_"+k {
+					return Problem{}, true
+				}
+			}
+		}
+	}
+	return problemAt(n, true, "identifying relation: %s has no *+ column referring to %s",
+		candidates[0][0].Text, candidates[0][1].Text), false
+}
+
+// Validate checks a syntax tree for mistakes the grammar lets through,
+// such as invalid attributes of the graph directive. Relations to unknown
+// tables are only warnings, as the tables may be defined in another file.
+func Validate(f *SyntaxFile) []Problem {
+	var problems []Problem
+	tables := tableDefinitions(f)
+	nodes := tableNodes(f)
+	enums := map[string]*SyntaxNode{}
+	groups := map[string]string{} // the group of each table
+
+	// inGroup reports a table put in a second group, as Graphviz draws a
+	// node in one cluster only
+	inGroup := func(n *SyntaxNode, table, group string) {
+		name := replaceAllIllegal(table)
+		if first, ok := groups[name]; ok && replaceAllIllegal(first) != replaceAllIllegal(group) {
+			problems = append(problems, problemAt(n, false, "table %q is already in group %q", table, first))
+			return
+		}
+		groups[name] = group
+	}
+
+	f.Walk(func(n *SyntaxNode) {
+		switch n.Kind {
+		case SyntaxTable:
+			name := n.Child(SyntaxName)
+			if first := tables[replaceAllIllegal(name.Text)]; first != name {
+				problems = append(problems, problemAt(name, false,
+					"table %q is already defined at line %d", name.Text, first.Begin.Line))
+			}
+			for _, a := range n.ChildrenOf(SyntaxAttribute) {
+				if a.Child(SyntaxKey).Text == "group" {
+					inGroup(a, name.Text, a.Child(SyntaxValue).Text)
+				}
+			}
+
+			if first := tables[replaceAllIllegal(name.Text)]; first == name && extendsItself(n, nodes) {
+				parent := n.Child(SyntaxReference)
+				problems = append(problems, problemAt(parent, false,
+					"table %q extends itself through %q", name.Text, parent.Text))
+			}
+			problems = append(problems, separatedColumns(n, "table")...)
+		case SyntaxColumn:
+			problems = append(problems, definedTwice(n)...)
+		case SyntaxRelationship:
+			problems = append(problems, separatedColumns(n, "relationship")...)
+		case SyntaxEnum:
+			name := n.Child(SyntaxName)
+			if first, ok := enums[name.Text]; ok {
+				problems = append(problems, problemAt(name, false,
+					"enum %q is already defined at line %d", name.Text, first.Begin.Line))
+			} else {
+				enums[name.Text] = name
+			}
+			values := map[string]bool{}
+			for _, v := range n.ChildrenOf(SyntaxValue) {
+				if values[v.Text] {
+					problems = append(problems, problemAt(v, true, "duplicate value %q", v.Text))
+				}
+				values[v.Text] = true
+			}
+			if len(values) == 0 {
+				problems = append(problems, problemAt(name, true, "enum %q has no values", name.Text))
+			}
+		case SyntaxNote:
+			if p, ok := checkAttach(n, nodes); !ok {
+				problems = append(problems, p)
+			}
+		case SyntaxTitle:
+			for _, a := range n.ChildrenOf(SyntaxAttribute) {
+				if a.Child(SyntaxKey).Text != "theme" {
+					continue
+				}
+				if name := a.Child(SyntaxValue).Text; isThemeFile(name) {
+					problems = append(problems, problemAt(a, false,
+						"theme %q: theme files are only loaded with --theme", name))
+				} else if _, ok := themes[name]; !ok {
+					problems = append(problems, problemAt(a, false,
+						"unknown theme %q, want one of %s", name, strings.Join(themeNames(), ", ")))
+				}
+			}
+		case SyntaxGraph:
+			for _, a := range n.ChildrenOf(SyntaxAttribute) {
+				err := checkLayout(a.Child(SyntaxKey).Text, a.Child(SyntaxValue).Text)
+				if err != nil {
+					problems = append(problems, problemAt(a, false, "%v", err))
+				}
+			}
+		case SyntaxRelation:
+			if p, ok := checkIdentifying(n, nodes); !ok {
+				problems = append(problems, p)
+			}
+		case SyntaxGroup:
+			for _, m := range n.ChildrenOf(SyntaxReference) {
+				inGroup(m, m.Text, n.Child(SyntaxName).Text)
+			}
+		case SyntaxReference:
+			if _, ok := tables[replaceAllIllegal(n.Text)]; !ok {
+				p := problemAt(n, true, "unknown table %q", n.Text)
+				p.table = n.Text
+				problems = append(problems, p)
+			}
+		}
+
+		keys := map[string]bool{}
+		for _, a := range n.ChildrenOf(SyntaxAttribute) {
+			key := a.Child(SyntaxKey)
+			if keys[key.Text] {
+				problems = append(problems, problemAt(key, true, "duplicate attribute %q", key.Text))
+			}
+			keys[key.Text] = true
+		}
+	})
+	return problems
+}
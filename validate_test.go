@@ -0,0 +1,100 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	f, err := ParseSyntax(`[a] {bgcolor: "#fff", bgcolor: "#000"}
+  id
+
+[a]
+  x
+
+a 1--* b
+group g {a, c}
+graph {rankdir: up, edge.shape: box, spline: ortho}
+title {theme: sepia}
+[p]
+  *id
+[q]
+  *+p_id
+[r]
+  *+id
+  x
+p 1==* q
+p 1==+ r
+q 1--* r {identifying: true}
+q *==* r
+[s] extends t
+[t] extends s
+[u] extends u
+[v] extends w
+enum e {a, b, a}
+enum e {}
+[typed]
+  id int not null {type: bigint, null: true, label: x}
+note "about p" {attach: p.id}
+note "about x" {attach: p.x}
+note "about z" {attach: z}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, p := range Validate(f) {
+		got = append(got, p.String())
+	}
+	want := []string{
+		`1:23: warning: duplicate attribute "bgcolor"`,
+		`4:2: table "a" is already defined at line 1`,
+		`7:8: warning: unknown table "b"`,
+		`8:13: warning: unknown table "c"`,
+		`9:8: invalid rankdir "up", want TB, LR, BT or RL`,
+		`9:21: graph attribute "shape" cannot be set on edge`,
+		`9:38: unknown graph attribute "spline"`,
+		`10:8: unknown theme "sepia", want one of dark, default, high-contrast, monochrome, print`,
+		`20:1: warning: identifying relation: r has no *+ column referring to q`,
+		`21:1: warning: a many-to-many relation cannot be identifying`,
+		`22:13: table "s" extends itself through "t"`,
+		`23:13: table "t" extends itself through "s"`,
+		`24:13: table "u" extends itself through "u"`,
+		`25:13: warning: unknown table "w"`,
+		`26:15: warning: duplicate value "a"`,
+		`27:6: enum "e" is already defined at line 26`,
+		`27:6: warning: enum "e" has no values`,
+		`29:20: warning: type is already set by the definition of column "id"`,
+		`29:34: warning: null is already set by the definition of column "id"`,
+		`31:25: warning: table "p" has no column "x"`,
+		`32:25: warning: unknown table "z"`,
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got:\n%v\nwant:\n%v", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestValidate_groups(t *testing.T) {
+	f, err := ParseSyntax(`[a]
+[b] {group: h}
+[c] {group: g}
+group g {a, b, c}
+group h {a}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, p := range Validate(f) {
+		got = append(got, p.String())
+	}
+	want := []string{
+		`4:13: table "b" is already in group "h"`,
+		`5:10: table "a" is already in group "g"`,
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got:\n%v\nwant:\n%v", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
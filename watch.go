@@ -0,0 +1,97 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	watchInterval = 250 * time.Millisecond
+	watchDebounce = 100 * time.Millisecond
+)
+
+// fileState is what the watcher compares to notice a changed file
+type fileState struct {
+	ModTime time.Time
+	Size    int64
+}
+
+// watcher polls the files matching the patterns for changes. Polling keeps
+// working on network file systems and with editors which replace the file
+// on save.
+type watcher struct {
+	Patterns []string
+	Interval time.Duration // between two polls
+	Debounce time.Duration // quiet time required after a change
+}
+
+// snapshot returns the state of every file matching the patterns. The
+// patterns are expanded again each time, so new files are picked up.
+func (w *watcher) snapshot() map[string]fileState {
+	files := map[string]fileState{}
+	for _, pattern := range w.Patterns {
+		matches, _ := filepath.Glob(pattern)
+		if len(matches) == 0 {
+			matches = []string{pattern}
+		}
+		for _, name := range matches {
+			info, err := os.Stat(name)
+			if err != nil {
+				// a missing file is a change once it comes back
+				files[name] = fileState{}
+				continue
+			}
+			files[name] = fileState{ModTime: info.ModTime(), Size: info.Size()}
+		}
+	}
+	return files
+}
+
+func sameFiles(a, b map[string]fileState) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, state := range a {
+		if other, ok := b[name]; !ok || other != state {
+			return false
+		}
+	}
+	return true
+}
+
+// Run calls build once and then after every change of the files, until stop
+// is closed. A burst of changes, as editors write on save, results in a
+// single build once the files have been quiet for Debounce.
+func (w *watcher) Run(build func(), stop <-chan struct{}) {
+	last := w.snapshot()
+	build()
+
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+		current := w.snapshot()
+		if sameFiles(last, current) {
+			continue
+		}
+		for {
+			select {
+			case <-stop:
+				return
+			case <-time.After(w.Debounce):
+			}
+			next := w.snapshot()
+			if sameFiles(current, next) {
+				break
+			}
+			current = next
+		}
+		last = current
+		build()
+	}
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWatcher(t *testing.T) {
+	dir, err := ioutil.TempDir("", "erd-watch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "a.er")
+	err = ioutil.WriteFile(name, []byte("[a]\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	builds := make(chan struct{}, 10)
+	stop := make(chan struct{})
+	w := &watcher{Patterns: []string{filepath.Join(dir, "*.er")}, Interval: 10 * time.Millisecond, Debounce: 50 * time.Millisecond}
+	go w.Run(func() { builds <- struct{}{} }, stop)
+	defer close(stop)
+
+	wait := func(what string) {
+		select {
+		case <-builds:
+		case <-time.After(2 * time.Second):
+			t.Fatalf("no build after %s", what)
+		}
+	}
+	wait("start")
+
+	// a burst of writes is built once
+	for _, contents := range []string{"[a]\n*id\n", "[a]\n*id\nname\n"} {
+		err = ioutil.WriteFile(name, []byte(contents), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	wait("change")
+	select {
+	case <-builds:
+		t.Errorf("got: two builds for a burst of writes\nwant: one build")
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	// new files matching the pattern are picked up
+	err = ioutil.WriteFile(filepath.Join(dir, "b.er"), []byte("[b]\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wait("new file")
+}
+
+func TestBuild_warnings(t *testing.T) {
+	warnings, err := check(input{Name: "a.er", Contents: "[a]\n*id\n\na 1--* b\n"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(warnings) != 1 || warnings[0].table != "b" {
+		t.Errorf("got: %v\nwant: a warning for table b", warnings)
+	}
+
+	_, err = check(input{Name: "a.er", Contents: "[a]\n[a]\n"})
+	if err == nil || err.Error() != `a.er:2:2: table "a" is already defined at line 1` {
+		t.Errorf("got: %v\nwant: the duplicate table error", err)
+	}
+}